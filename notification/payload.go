@@ -0,0 +1,111 @@
+// Package notification contains the payloads Terraform Cloud and Terraform
+// Enterprise send to notification configurations with a destination type of
+// generic, along with helpers to verify their authenticity.
+//
+// API docs:
+// https://www.terraform.io/cloud-docs/api-docs/notification-configurations#notification-payload
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// PayloadVersion is the version of a notification payload. Run notifications
+// are sent with version 1 and health assessment notifications with version 2.
+type PayloadVersion int
+
+const (
+	// PayloadVersionRun is the payload version used for run notifications.
+	PayloadVersionRun PayloadVersion = 1
+
+	// PayloadVersionAssessment is the payload version used for health
+	// assessment notifications.
+	PayloadVersionAssessment PayloadVersion = 2
+)
+
+// UnmarshalJSON accepts the payload version both as a number, which is how it
+// is sent for run notifications, and as a string, which is how it is sent for
+// health assessment notifications.
+func (v *PayloadVersion) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch value := raw.(type) {
+	case float64:
+		*v = PayloadVersion(value)
+	case string:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*v = PayloadVersion(i)
+	default:
+		return fmt.Errorf("invalid payload version: %s", data)
+	}
+
+	return nil
+}
+
+// Payload represents the body of a notification request. Only the fields
+// relevant to the payload version are populated.
+type Payload struct {
+	PayloadVersion               PayloadVersion  `json:"payload_version"`
+	NotificationConfigurationID  string          `json:"notification_configuration_id"`
+	NotificationConfigurationURL string          `json:"notification_configuration_url,omitempty"`
+	RunURL                       string          `json:"run_url,omitempty"`
+	RunID                        string          `json:"run_id,omitempty"`
+	RunMessage                   string          `json:"run_message,omitempty"`
+	RunCreatedAt                 *time.Time      `json:"run_created_at,omitempty"`
+	RunCreatedBy                 string          `json:"run_created_by,omitempty"`
+	WorkspaceID                  string          `json:"workspace_id"`
+	WorkspaceName                string          `json:"workspace_name"`
+	OrganizationName             string          `json:"organization_name"`
+	Notifications                []*Notification `json:"notifications"`
+	Details                      *Details        `json:"details,omitempty"`
+}
+
+// Notification represents a single notification within a payload.
+type Notification struct {
+	Message      string     `json:"message"`
+	Trigger      string     `json:"trigger"`
+	RunStatus    string     `json:"run_status,omitempty"`
+	RunUpdatedAt *time.Time `json:"run_updated_at,omitempty"`
+	RunUpdatedBy string     `json:"run_updated_by,omitempty"`
+}
+
+// Details contains the health assessment results of a version 2 payload.
+type Details struct {
+	NewAssessmentResult   *AssessmentResult `json:"new_assessment_result"`
+	PriorAssessmentResult *AssessmentResult `json:"prior_assessment_result"`
+}
+
+// AssessmentResult represents the result of a single health assessment.
+type AssessmentResult struct {
+	ID                 string    `json:"id"`
+	URL                string    `json:"url"`
+	Succeeded          bool      `json:"succeeded"`
+	Drifted            bool      `json:"drifted"`
+	AllChecksSucceeded bool      `json:"all_checks_succeeded"`
+	ResourcesDrifted   int       `json:"resources_drifted"`
+	ResourcesUndrifted int       `json:"resources_undrifted"`
+	ChecksPassed       int       `json:"checks_passed"`
+	ChecksFailed       int       `json:"checks_failed"`
+	ChecksErrored      int       `json:"checks_errored"`
+	ChecksUnknown      int       `json:"checks_unknown"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// Parse decodes the body of a notification request into a Payload.
+func Parse(body []byte) (*Payload, error) {
+	payload := &Payload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
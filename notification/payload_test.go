@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"testing"
+)
+
+func TestParse_run(t *testing.T) {
+	body := []byte(`{
+  "payload_version": 1,
+  "notification_configuration_id": "nc-AeUQ2zfKZzW9TiGZ",
+  "run_url": "https://app.terraform.io/app/acme-org/my-workspace/runs/run-FwnENkvDnrpyFC7M",
+  "run_id": "run-FwnENkvDnrpyFC7M",
+  "run_message": "Add five new queue workers",
+  "run_created_at": "2019-01-25T18:34:00.000Z",
+  "run_created_by": "sample-user",
+  "workspace_id": "ws-XdeUVMWShTesDMME",
+  "workspace_name": "my-workspace",
+  "organization_name": "acme-org",
+  "notifications": [
+    {
+      "message": "Run Canceled",
+      "trigger": "run:errored",
+      "run_status": "canceled",
+      "run_updated_at": "2019-01-25T18:37:04.000Z",
+      "run_updated_by": "sample-user"
+    }
+  ]
+}`)
+
+	payload, err := Parse(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if payload.PayloadVersion != PayloadVersionRun {
+		t.Fatalf("expected payload version %d, got %d", PayloadVersionRun, payload.PayloadVersion)
+	}
+	if payload.RunID != "run-FwnENkvDnrpyFC7M" {
+		t.Fatalf("bad run ID: %s", payload.RunID)
+	}
+	if len(payload.Notifications) != 1 || payload.Notifications[0].RunStatus != "canceled" {
+		t.Fatalf("bad notifications: %v", payload.Notifications)
+	}
+	if payload.Details != nil {
+		t.Fatalf("expected no assessment details, got %v", payload.Details)
+	}
+}
+
+func TestParse_assessment(t *testing.T) {
+	body := []byte(`{
+  "payload_version": "2",
+  "notification_configuration_url": "https://app.terraform.io/api/v2/notification-configurations/nc-SZ3V3cLFxK6sqLKn",
+  "notification_configuration_id": "nc-SZ3V3cLFxK6sqLKn",
+  "workspace_id": "ws-XdeUVMWShTesDMME",
+  "workspace_name": "my-workspace",
+  "organization_name": "acme-org",
+  "notifications": [
+    {
+      "trigger": "assessment:drifted",
+      "message": "Drift Detected"
+    }
+  ],
+  "details": {
+    "new_assessment_result": {
+      "id": "asmtres-vRVQxpqq64EA9V5a",
+      "url": "https://app.terraform.io/api/v2/assessment-results/asmtres-vRVQxpqq64EA9V5a",
+      "succeeded": true,
+      "drifted": true,
+      "all_checks_succeeded": true,
+      "resources_drifted": 4,
+      "resources_undrifted": 55,
+      "checks_passed": 33,
+      "checks_failed": 0,
+      "checks_errored": 0,
+      "checks_unknown": 0,
+      "created_at": "2022-06-09T05:23:10Z"
+    },
+    "prior_assessment_result": {
+      "id": "asmtres-A6zEbpGArqP74fdL",
+      "url": "https://app.terraform.io/api/v2/assessment-results/asmtres-A6zEbpGArqP74fdL",
+      "succeeded": true,
+      "drifted": true,
+      "all_checks_succeeded": true,
+      "resources_drifted": 3,
+      "resources_undrifted": 55,
+      "checks_passed": 33,
+      "checks_failed": 0,
+      "checks_errored": 0,
+      "checks_unknown": 0,
+      "created_at": "2022-06-09T05:22:51Z"
+    }
+  }
+}`)
+
+	payload, err := Parse(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if payload.PayloadVersion != PayloadVersionAssessment {
+		t.Fatalf("expected payload version %d, got %d", PayloadVersionAssessment, payload.PayloadVersion)
+	}
+	if payload.Details == nil || payload.Details.NewAssessmentResult == nil {
+		t.Fatalf("expected assessment details")
+	}
+	if payload.Details.NewAssessmentResult.ResourcesDrifted != 4 {
+		t.Fatalf("bad drifted resources count: %d", payload.Details.NewAssessmentResult.ResourcesDrifted)
+	}
+}
@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// SignatureHeader is the HTTP header containing the signature of the request
+// body. It is only sent when the notification configuration has a token.
+const SignatureHeader = "X-TFE-Notification-Signature"
+
+var (
+	// ErrMissingSignature is returned when the signature header is empty.
+	ErrMissingSignature = errors.New("missing notification signature")
+
+	// ErrInvalidSignature is returned when the signature does not match the
+	// body and token.
+	ErrInvalidSignature = errors.New("invalid notification signature")
+)
+
+// VerifySignature checks that header, the value of the SignatureHeader sent
+// with a notification request, is the HMAC-SHA512 signature of body using the
+// token of the notification configuration.
+func VerifySignature(body []byte, header string, token string) error {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	signature, err := hex.DecodeString(header)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal(signature, sign(body, token)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func sign(body []byte, token string) []byte {
+	mac := hmac.New(sha512.New, []byte(token))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"payload_version":1,"notification_configuration_id":"nc-AeUQ2zfKZzW9TiGZ"}`)
+	token := "secret-token"
+	valid := hex.EncodeToString(sign(body, token))
+
+	cases := map[string]struct {
+		body   []byte
+		header string
+		token  string
+		err    error
+	}{
+		"valid signature": {
+			body:   body,
+			header: valid,
+			token:  token,
+		},
+		"missing signature": {
+			body:   body,
+			header: "",
+			token:  token,
+			err:    ErrMissingSignature,
+		},
+		"signature is not hex": {
+			body:   body,
+			header: "not-a-signature",
+			token:  token,
+			err:    ErrInvalidSignature,
+		},
+		"wrong token": {
+			body:   body,
+			header: valid,
+			token:  "another-token",
+			err:    ErrInvalidSignature,
+		},
+		"tampered body": {
+			body:   []byte(`{"payload_version":1,"notification_configuration_id":"nc-tampered"}`),
+			header: valid,
+			token:  token,
+			err:    ErrInvalidSignature,
+		},
+	}
+
+	for name, tc := range cases {
+		err := VerifySignature(tc.body, tc.header, tc.token)
+		if err != tc.err {
+			t.Fatalf("%s: expected error %v, got %v", name, tc.err, err)
+		}
+	}
+}
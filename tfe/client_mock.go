@@ -3,7 +3,9 @@ package tfe
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
@@ -62,7 +64,16 @@ func (m *mockWorkspaces) ReadByIDWithOptions(ctx context.Context, workspaceID st
 }
 
 func (m *mockWorkspaces) Readme(ctx context.Context, workspaceID string) (io.Reader, error) {
-	panic("not implemented")
+	if err, ok := m.options.readmeErrors[workspaceID]; ok {
+		return nil, err
+	}
+
+	readme, ok := m.options.readmes[workspaceID]
+	if !ok {
+		return nil, nil
+	}
+
+	return strings.NewReader(readme), nil
 }
 
 func (m *mockWorkspaces) ReadByID(ctx context.Context, workspaceID string) (*tfe.Workspace, error) {
@@ -85,6 +96,14 @@ func (m *mockWorkspaces) DeleteByID(ctx context.Context, workspaceID string) err
 	panic("not implemented")
 }
 
+func (m *mockWorkspaces) SafeDelete(ctx context.Context, organization string, workspace string) error {
+	panic("not implemented")
+}
+
+func (m *mockWorkspaces) SafeDeleteByID(ctx context.Context, workspaceID string) error {
+	panic("not implemented")
+}
+
 func (m *mockWorkspaces) RemoveVCSConnection(ctx context.Context, organization string, workspace string) (*tfe.Workspace, error) {
 	panic("not implemented")
 }
@@ -146,3 +165,146 @@ func (m *mockWorkspaces) AddTags(ctx context.Context, workspaceID string, option
 func (m *mockWorkspaces) RemoveTags(ctx context.Context, workspaceID string, options tfe.WorkspaceRemoveTagsOptions) error {
 	panic("not implemented")
 }
+
+func (m *mockWorkspaces) ReadDataRetentionPolicy(ctx context.Context, workspaceID string) (*tfe.DataRetentionPolicy, error) {
+	panic("not implemented")
+}
+
+func (m *mockWorkspaces) SetDataRetentionPolicy(ctx context.Context, workspaceID string, options tfe.DataRetentionPolicySetOptions) (*tfe.DataRetentionPolicy, error) {
+	panic("not implemented")
+}
+
+func (m *mockWorkspaces) DeleteDataRetentionPolicy(ctx context.Context, workspaceID string) error {
+	panic("not implemented")
+}
+
+type mockPlans struct {
+	options testClientOptions
+}
+
+func newMockPlans(options testClientOptions) *mockPlans {
+	return &mockPlans{options: options}
+}
+
+func (m *mockPlans) Read(ctx context.Context, planID string) (*tfe.Plan, error) {
+	p, ok := m.options.plans[planID]
+	if !ok {
+		return nil, tfe.ErrResourceNotFound
+	}
+
+	return p, nil
+}
+
+func (m *mockPlans) Logs(ctx context.Context, planID string) (io.Reader, error) {
+	panic("not implemented")
+}
+
+func (m *mockPlans) ReadJSONOutput(ctx context.Context, planID string) ([]byte, error) {
+	panic("not implemented")
+}
+
+type mockPlanExports struct {
+	options     testClientOptions
+	planExports map[string]*tfe.PlanExport
+}
+
+// newMockPlanExports creates a mock plan exports implementation. Created
+// exports are finished right away, and every export downloads the bundle
+// given in planExportBundle.
+func newMockPlanExports(options testClientOptions) *mockPlanExports {
+	planExports := make(map[string]*tfe.PlanExport)
+	for id, pe := range options.planExports {
+		planExports[id] = pe
+	}
+
+	return &mockPlanExports{
+		options:     options,
+		planExports: planExports,
+	}
+}
+
+func (m *mockPlanExports) Create(ctx context.Context, options tfe.PlanExportCreateOptions) (*tfe.PlanExport, error) {
+	pe := &tfe.PlanExport{
+		ID:       fmt.Sprintf("pe-created-%d", len(m.planExports)),
+		DataType: *options.DataType,
+		Status:   tfe.PlanExportFinished,
+	}
+
+	m.planExports[pe.ID] = pe
+
+	return pe, nil
+}
+
+func (m *mockPlanExports) Read(ctx context.Context, planExportID string) (*tfe.PlanExport, error) {
+	pe, ok := m.planExports[planExportID]
+	if !ok {
+		return nil, tfe.ErrResourceNotFound
+	}
+
+	return pe, nil
+}
+
+func (m *mockPlanExports) Delete(ctx context.Context, planExportID string) error {
+	panic("not implemented")
+}
+
+func (m *mockPlanExports) Download(ctx context.Context, planExportID string) ([]byte, error) {
+	if _, ok := m.planExports[planExportID]; !ok {
+		return nil, tfe.ErrResourceNotFound
+	}
+
+	return m.options.planExportBundle, nil
+}
+
+type mockAdminTerraformVersions struct {
+	options testClientOptions
+}
+
+// newMockAdminTerraformVersions creates a mock admin Terraform versions
+// implementation, which lists the versions given in terraformVersions one per
+// page, or fails with terraformVersionsError when it is set.
+func newMockAdminTerraformVersions(options testClientOptions) *mockAdminTerraformVersions {
+	return &mockAdminTerraformVersions{options: options}
+}
+
+func (m *mockAdminTerraformVersions) List(ctx context.Context, options *tfe.AdminTerraformVersionsListOptions) (*tfe.AdminTerraformVersionsList, error) {
+	if m.options.terraformVersionsError != nil {
+		return nil, m.options.terraformVersionsError
+	}
+
+	total := len(m.options.terraformVersions)
+	page := 1
+	if options != nil && options.PageNumber > 0 {
+		page = options.PageNumber
+	}
+
+	l := &tfe.AdminTerraformVersionsList{
+		Pagination: &tfe.Pagination{
+			CurrentPage: page,
+			NextPage:    page + 1,
+			TotalPages:  total,
+			TotalCount:  total,
+		},
+	}
+	if page <= total {
+		l.Items = m.options.terraformVersions[page-1 : page]
+	}
+
+	return l, nil
+}
+
+func (m *mockAdminTerraformVersions) Read(ctx context.Context, id string) (*tfe.AdminTerraformVersion, error) {
+	panic("not implemented")
+}
+
+func (m *mockAdminTerraformVersions) Create(ctx context.Context, options tfe.AdminTerraformVersionCreateOptions) (*tfe.AdminTerraformVersion, error) {
+	panic("not implemented")
+}
+
+func (m *mockAdminTerraformVersions) Update(ctx context.Context, id string, options tfe.AdminTerraformVersionUpdateOptions) (*tfe.AdminTerraformVersion, error) {
+	panic("not implemented")
+}
+
+func (m *mockAdminTerraformVersions) Delete(ctx context.Context, id string) error {
+	panic("not implemented")
+}
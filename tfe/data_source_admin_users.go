@@ -0,0 +1,159 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEAdminUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEAdminUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"site_admins_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"suspended_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"two_factor_disabled_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"site_admin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"suspended": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"two_factor_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"is_service_account": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEAdminUsersRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create an options struct.
+	options := &tfe.AdminUserListOptions{
+		ListOptions: tfe.ListOptions{
+			PageSize: 100,
+		},
+		Query: d.Get("search").(string),
+	}
+
+	if d.Get("site_admins_only").(bool) {
+		options.Administrators = "true"
+	}
+
+	if d.Get("suspended_only").(bool) {
+		options.SuspendedUsers = "true"
+	}
+
+	// The API has no filter for the two factor authentication status, so
+	// those users are filtered out here.
+	twoFactorDisabledOnly := d.Get("two_factor_disabled_only").(bool)
+
+	ids := []string{}
+	users := []map[string]interface{}{}
+
+	log.Printf("[DEBUG] Listing all users (admin)")
+	for {
+		l, err := tfeClient.Admin.Users.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving users: %v", err)
+		}
+
+		for _, user := range l.Items {
+			twoFactorEnabled := user.TwoFactor != nil && user.TwoFactor.Enabled
+			if twoFactorDisabledOnly && twoFactorEnabled {
+				continue
+			}
+
+			ids = append(ids, user.ID)
+			users = append(users, map[string]interface{}{
+				"id":                 user.ID,
+				"username":           user.Username,
+				"email":              user.Email,
+				"site_admin":         user.IsAdmin,
+				"suspended":          user.IsSuspended,
+				"two_factor_enabled": twoFactorEnabled,
+				"is_service_account": user.IsServiceAccount,
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("users/%s/%t/%t/%t",
+		options.Query, options.Administrators != "", options.SuspendedUsers != "", twoFactorDisabledOnly))
+	d.Set("ids", ids)
+	if err := d.Set("users", users); err != nil {
+		return fmt.Errorf("Error setting users: %v", err)
+	}
+
+	return nil
+}
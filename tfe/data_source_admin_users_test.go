@@ -0,0 +1,66 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminUsersDataSource_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_admin_users.all", "ids.#"),
+					testAccCheckTFEAdminUsersAllMatch(
+						"data.tfe_admin_users.admins", "site_admin", "true"),
+					testAccCheckTFEAdminUsersAllMatch(
+						"data.tfe_admin_users.no_two_factor", "two_factor_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTFEAdminUsersAllMatch checks that every user returned by the
+// data source has the given attribute value.
+func testAccCheckTFEAdminUsersAllMatch(n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			var i int
+			var attr string
+			if _, err := fmt.Sscanf(k, "users.%d.%s", &i, &attr); err != nil || attr != key {
+				continue
+			}
+			if v != value {
+				return fmt.Errorf("Bad %s: expected %s, got %s", k, value, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccTFEAdminUsersDataSourceConfig = `
+data "tfe_admin_users" "all" {}
+
+data "tfe_admin_users" "admins" {
+  site_admins_only = true
+}
+
+data "tfe_admin_users" "no_two_factor" {
+  two_factor_disabled_only = true
+}`
@@ -0,0 +1,81 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEGHAInstallation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEGHAInstallationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "installation_id"},
+			},
+
+			"installation_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "installation_id"},
+			},
+		},
+	}
+}
+
+func dataSourceTFEGHAInstallationRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	name, nameOk := d.GetOk("name")
+	installationID, installationIDOk := d.GetOk("installation_id")
+
+	// Create an options struct.
+	options := &tfe.GHAInstallationListOptions{}
+
+	log.Printf("[DEBUG] Read GitHub App installations")
+	for {
+		l, err := tfeClient.GHAInstallations.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving GitHub App installations: %v", err)
+		}
+
+		for _, i := range l.Items {
+			if nameOk && (i.Name == nil || *i.Name != name.(string)) {
+				continue
+			}
+			if installationIDOk && (i.InstallationID == nil || *i.InstallationID != installationID.(int)) {
+				continue
+			}
+
+			d.SetId(*i.ID)
+			if i.Name != nil {
+				d.Set("name", *i.Name)
+			}
+			if i.InstallationID != nil {
+				d.Set("installation_id", *i.InstallationID)
+			}
+
+			return nil
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.Pagination == nil || l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	if nameOk {
+		return fmt.Errorf("Could not find GitHub App installation with name %s", name)
+	}
+	return fmt.Errorf("Could not find GitHub App installation with installation ID %d", installationID)
+}
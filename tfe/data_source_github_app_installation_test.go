@@ -0,0 +1,38 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEGHAInstallationDataSource_findByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_APP_INSTALLATION_NAME == "" {
+				t.Skip("Please set GITHUB_APP_INSTALLATION_NAME to run this test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEGHAInstallationDataSourceConfig_findByName(GITHUB_APP_INSTALLATION_NAME),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_github_app_installation.gha", "name", GITHUB_APP_INSTALLATION_NAME),
+					resource.TestCheckResourceAttrSet("data.tfe_github_app_installation.gha", "installation_id"),
+					resource.TestCheckResourceAttrSet("data.tfe_github_app_installation.gha", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEGHAInstallationDataSourceConfig_findByName(name string) string {
+	return fmt.Sprintf(`
+data "tfe_github_app_installation" "gha" {
+  name = "%s"
+}`, name)
+}
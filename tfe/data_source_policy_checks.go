@@ -0,0 +1,284 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEPolicyChecks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEPolicyChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"run_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"run_id", "workspace_id"},
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"run_id", "workspace_id"},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"result": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"overridden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"policy_set": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enforcement_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"passed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEPolicyChecksRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	runID := d.Get("run_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	if runID != "" {
+		log.Printf("[DEBUG] Read run: %s", runID)
+		run, err := tfeClient.Runs.Read(ctx, runID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving run %s: %v", runID, err)
+		}
+		workspaceID = run.Workspace.ID
+	}
+
+	log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving workspace %s: %v", workspaceID, err)
+	}
+
+	// Without a run ID, report on the latest run of the workspace.
+	if runID == "" {
+		if workspace.CurrentRun == nil {
+			return fmt.Errorf("Workspace %s has no runs", workspaceID)
+		}
+		runID = workspace.CurrentRun.ID
+	}
+
+	status := ""
+	result := true
+	overridden := false
+	var results []policyCheckPolicyResult
+
+	options := &tfe.PolicyCheckListOptions{}
+	for {
+		log.Printf("[DEBUG] List policy checks of run: %s", runID)
+		l, err := tfeClient.PolicyChecks.List(ctx, runID, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving policy checks of run %s: %v", runID, err)
+		}
+
+		for _, pc := range l.Items {
+			status = string(pc.Status)
+			if pc.Status == tfe.PolicyOverridden {
+				overridden = true
+			}
+			if pc.Result != nil {
+				result = result && pc.Result.Result
+				results = append(results, flattenPolicyCheckSentinelResults(pc.Result.Sentinel)...)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	if status == "" {
+		// There is nothing to report for a run without policy checks.
+		result = false
+	}
+
+	levels, err := fetchPolicyEnforcementLevels(tfeClient, workspace.Organization.Name)
+	if err != nil {
+		// The policy checks are still useful without the exact enforcement
+		// levels, for example when the token can't list policies.
+		log.Printf("[WARN] Unable to retrieve policy enforcement levels: %v", err)
+	}
+
+	policies := make([]map[string]interface{}, 0, len(results))
+	for _, r := range results {
+		// The check only reports whether a failure was allowed, so the exact
+		// mandatory enforcement level comes from the policy itself.
+		level := string(tfe.EnforcementMandatory)
+		if r.AllowedFailure {
+			level = string(tfe.EnforcementAdvisory)
+		} else if v, ok := levels[r.Name]; ok {
+			level = v
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"name":              r.Name,
+			"policy_set":        r.PolicySet,
+			"enforcement_level": level,
+			"passed":            r.Passed,
+			"error":             r.Error,
+		})
+	}
+
+	d.SetId(runID)
+	d.Set("run_id", runID)
+	d.Set("workspace_id", workspaceID)
+	d.Set("status", status)
+	d.Set("result", result)
+	d.Set("overridden", overridden)
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("Error setting policies: %v", err)
+	}
+
+	return nil
+}
+
+// policyCheckPolicyResult holds the result of a single policy in a policy
+// check.
+type policyCheckPolicyResult struct {
+	Name           string
+	PolicySet      string
+	AllowedFailure bool
+	Passed         bool
+	Error          string
+}
+
+// flattenPolicyCheckSentinelResults extracts the result of each policy from
+// the raw Sentinel result of a policy check, which is structured as:
+//
+//	{"data": {"<policy set>": {"policies": [{"policy": "<policy set>/<policy>", ...}]}}}
+func flattenPolicyCheckSentinelResults(sentinel interface{}) []policyCheckPolicyResult {
+	raw, ok := sentinel.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	data, ok := raw["data"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	// Sort the policy sets to return the results in a stable order.
+	var policySets []string
+	for name := range data {
+		policySets = append(policySets, name)
+	}
+	sort.Strings(policySets)
+
+	var results []policyCheckPolicyResult
+	for _, policySet := range policySets {
+		set, ok := data[policySet].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		policies, _ := set["policies"].([]interface{})
+		for _, p := range policies {
+			policy, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _ := policy["policy"].(string)
+			name = strings.TrimPrefix(name, policySet+"/")
+
+			result := policyCheckPolicyResult{
+				Name:      name,
+				PolicySet: policySet,
+			}
+			result.AllowedFailure, _ = policy["allowed-failure"].(bool)
+			result.Passed, _ = policy["result"].(bool)
+			if e := policy["error"]; e != nil {
+				result.Error = fmt.Sprint(e)
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// fetchPolicyEnforcementLevels returns the enforcement level of each policy
+// of the given organization, by policy name.
+func fetchPolicyEnforcementLevels(client *tfe.Client, organization string) (map[string]string, error) {
+	levels := make(map[string]string)
+
+	options := &tfe.PolicyListOptions{}
+	for {
+		l, err := client.Policies.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving policies: %v", err)
+		}
+
+		for _, policy := range l.Items {
+			if len(policy.Enforce) > 0 {
+				levels[policy.Name] = string(policy.Enforce[0].Mode)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return levels, nil
+}
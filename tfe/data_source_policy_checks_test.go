@@ -0,0 +1,89 @@
+package tfe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEPolicyChecksDataSource_noRuns(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEPolicyChecksDataSourceConfig(rInt),
+				ExpectError: regexp.MustCompile(`has no runs`),
+			},
+		},
+	})
+}
+
+func TestFlattenPolicyCheckSentinelResults(t *testing.T) {
+	var sentinel interface{}
+	err := json.Unmarshal([]byte(`{
+  "schema_version": 1,
+  "data": {
+    "security": {
+      "can-override": false,
+      "error": null,
+      "policies": [
+        {"allowed-failure": false, "error": null, "policy": "security/restrict-cidrs", "result": false},
+        {"allowed-failure": true, "error": null, "policy": "security/require-tags", "result": true}
+      ],
+      "result": false
+    },
+    "cost": {
+      "can-override": true,
+      "error": null,
+      "policies": [
+        {"allowed-failure": false, "error": "import \"tfrun\" not found", "policy": "cost/limit-cost", "result": false}
+      ],
+      "result": false
+    }
+  }
+}`), &sentinel)
+	if err != nil {
+		t.Fatalf("error decoding sentinel result: %v", err)
+	}
+
+	expected := []policyCheckPolicyResult{
+		{Name: "limit-cost", PolicySet: "cost", Error: `import "tfrun" not found`},
+		{Name: "restrict-cidrs", PolicySet: "security"},
+		{Name: "require-tags", PolicySet: "security", AllowedFailure: true, Passed: true},
+	}
+
+	results := flattenPolicyCheckSentinelResults(sentinel)
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, results)
+	}
+
+	if results := flattenPolicyCheckSentinelResults(nil); results != nil {
+		t.Fatalf("expected no results without a sentinel result, got %#v", results)
+	}
+}
+
+func testAccTFEPolicyChecksDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_policy_checks" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+}`, rInt)
+}
@@ -0,0 +1,189 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEPolicySet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEPolicySetRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"global": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"policies_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"workspace_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"vcs_repo": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ingress_submodules": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"oauth_token_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"github_app_installation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"parameter_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	ps, err := fetchPolicySetByName(tfeClient, organization, name)
+	if err != nil {
+		return err
+	}
+
+	// Read the policy set to get all of its relations, which are not
+	// necessarily part of the listing.
+	log.Printf("[DEBUG] Read policy set: %s", ps.ID)
+	policySet, err := tfeClient.PolicySets.Read(ctx, ps.ID)
+	if err != nil {
+		return fmt.Errorf("Error reading policy set %s: %v", ps.ID, err)
+	}
+
+	d.Set("description", policySet.Description)
+	d.Set("kind", string(policySet.Kind))
+	d.Set("global", policySet.Global)
+	d.Set("policies_path", policySet.PoliciesPath)
+
+	var policyIDs []interface{}
+	for _, policy := range policySet.Policies {
+		policyIDs = append(policyIDs, policy.ID)
+	}
+	d.Set("policy_ids", policyIDs)
+
+	var workspaceIDs []interface{}
+	if !policySet.Global {
+		for _, workspace := range policySet.Workspaces {
+			workspaceIDs = append(workspaceIDs, workspace.ID)
+		}
+	}
+	d.Set("workspace_ids", workspaceIDs)
+
+	var vcsRepo []interface{}
+	if policySet.VCSRepo != nil {
+		vcsRepo = append(vcsRepo, map[string]interface{}{
+			"identifier":                 policySet.VCSRepo.Identifier,
+			"branch":                     policySet.VCSRepo.Branch,
+			"ingress_submodules":         policySet.VCSRepo.IngressSubmodules,
+			"oauth_token_id":             policySet.VCSRepo.OAuthTokenID,
+			"github_app_installation_id": policySet.VCSRepo.GHAInstallationID,
+		})
+	}
+	d.Set("vcs_repo", vcsRepo)
+
+	// List the parameters, leaving out the values of sensitive parameters.
+	var parameterKeys []interface{}
+	parameters := make(map[string]interface{})
+
+	options := &tfe.PolicySetParameterListOptions{}
+	for {
+		l, err := tfeClient.PolicySetParameters.List(ctx, policySet.ID, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving parameters of policy set %s: %v", policySet.ID, err)
+		}
+
+		for _, p := range l.Items {
+			parameterKeys = append(parameterKeys, p.Key)
+			if !p.Sensitive {
+				parameters[p.Key] = p.Value
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.Set("parameter_keys", parameterKeys)
+	d.Set("parameters", parameters)
+	d.SetId(policySet.ID)
+
+	return nil
+}
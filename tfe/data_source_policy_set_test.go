@@ -0,0 +1,105 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEPolicySetDataSource_basic(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySetDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_policy_set.foobar", "id",
+						"tfe_policy_set.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "description", "Policy Set"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "kind", "sentinel"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "global", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "policy_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.tfe_policy_set.foobar", "policy_ids.*",
+						"tfe_sentinel_policy.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "workspace_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.tfe_policy_set.foobar", "workspace_ids.*",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "parameter_keys.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "parameters.%", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.foobar", "parameters.visible", "value"),
+					resource.TestCheckNoResourceAttr(
+						"data.tfe_policy_set.foobar", "parameters.secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEPolicySetDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_sentinel_policy" "foo" {
+  name         = "policy-foo"
+  policy       = "main = rule { true }"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_policy_set" "foobar" {
+  name          = "tst-terraform"
+  description   = "Policy Set"
+  organization  = tfe_organization.foobar.id
+  policy_ids    = [tfe_sentinel_policy.foo.id]
+  workspace_ids = [tfe_workspace.foobar.id]
+}
+
+resource "tfe_policy_set_parameter" "visible" {
+  key           = "visible"
+  value         = "value"
+  policy_set_id = tfe_policy_set.foobar.id
+}
+
+resource "tfe_policy_set_parameter" "secret" {
+  key           = "secret"
+  value         = "hunter2"
+  sensitive     = true
+  policy_set_id = tfe_policy_set.foobar.id
+}
+
+data "tfe_policy_set" "foobar" {
+  name         = tfe_policy_set.foobar.name
+  organization = tfe_organization.foobar.id
+
+  depends_on = [
+    tfe_policy_set_parameter.visible,
+    tfe_policy_set_parameter.secret,
+  ]
+}`, rInt)
+}
@@ -0,0 +1,107 @@
+package tfe
+
+import (
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEProject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"workspace_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFEProjectRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	project, err := fetchProjectByName(tfeClient, organization, name)
+	if err != nil {
+		return err
+	}
+
+	// List the workspaces belonging to the project.
+	var workspaceIDs []interface{}
+	options := &tfe.WorkspaceListOptions{
+		ProjectID: project.ID,
+	}
+	for {
+		wl, err := tfeClient.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving workspaces of project %s: %v", project.ID, err)
+		}
+
+		for _, w := range wl.Items {
+			workspaceIDs = append(workspaceIDs, w.ID)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	d.Set("workspace_ids", workspaceIDs)
+	d.SetId(project.ID)
+
+	return nil
+}
+
+// fetchProjectByName returns the project of an organization with the exact
+// given name.
+func fetchProjectByName(client *tfe.Client, organization, name string) (*tfe.Project, error) {
+	// Create an options struct. The name filter matches partial names, so
+	// the results still need to be compared against the exact name.
+	options := &tfe.ProjectListOptions{
+		Name: name,
+	}
+
+	for {
+		l, err := client.Projects.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving projects: %v", err)
+		}
+
+		for _, p := range l.Items {
+			if p.Name == name {
+				return p, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, fmt.Errorf("Could not find project %s/%s", organization, name)
+}
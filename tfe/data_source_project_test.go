@@ -0,0 +1,60 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEProjectDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProjectDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_project.foobar", "id", "tfe_project.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_project.foobar", "name", "project-test"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_project.foobar", "workspace_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.tfe_project.foobar", "workspace_ids.*", "tfe_workspace.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEProjectDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+}
+
+data "tfe_project" "foobar" {
+  name         = tfe_project.foobar.name
+  organization = tfe_organization.foobar.id
+
+  depends_on = [tfe_workspace.foobar]
+}`, rInt, rInt)
+}
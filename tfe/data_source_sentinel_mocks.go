@@ -0,0 +1,189 @@
+package tfe
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	slug "github.com/hashicorp/go-slug"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFESentinelMocks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFESentinelMocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"run_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"run_id", "workspace_id"},
+			},
+
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"run_id", "workspace_id"},
+			},
+
+			"output_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"plan_export_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFESentinelMocksRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	runID := d.Get("run_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	outputPath := d.Get("output_path").(string)
+
+	// Without a run ID, use the latest run of the workspace.
+	if runID == "" {
+		log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+		workspace, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving workspace %s: %v", workspaceID, err)
+		}
+
+		if workspace.CurrentRun == nil {
+			return fmt.Errorf("Workspace %s has no runs", workspaceID)
+		}
+		runID = workspace.CurrentRun.ID
+	}
+
+	log.Printf("[DEBUG] Read run: %s", runID)
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving run %s: %v", runID, err)
+	}
+
+	if run.Plan == nil {
+		return fmt.Errorf("Run %s has no plan", runID)
+	}
+
+	planExportID, err := downloadSentinelMocks(tfeClient, run.Plan.ID, outputPath)
+	if err != nil {
+		return fmt.Errorf("Error downloading Sentinel mocks of run %s: %v", runID, err)
+	}
+
+	d.SetId(planExportID)
+	d.Set("run_id", runID)
+	d.Set("workspace_id", run.Workspace.ID)
+	d.Set("plan_export_id", planExportID)
+
+	return nil
+}
+
+// downloadSentinelMocks extracts the Sentinel mocks of a plan to outputPath
+// and returns the ID of the plan export they were downloaded from. Exports
+// are kept for a while after they finished, so an existing export of the plan
+// is reused when there is one and a new export is only created otherwise.
+func downloadSentinelMocks(tfeClient *tfe.Client, planID, outputPath string) (string, error) {
+	pe, err := findSentinelMocksExport(tfeClient, planID)
+	if err != nil {
+		return "", err
+	}
+
+	if pe == nil {
+		options := tfe.PlanExportCreateOptions{
+			Plan:     &tfe.Plan{ID: planID},
+			DataType: tfe.PlanExportType(tfe.PlanExportSentinelMockBundleV0),
+		}
+
+		log.Printf("[DEBUG] Create Sentinel mock export of plan: %s", planID)
+		pe, err = tfeClient.PlanExports.Create(ctx, options)
+		if err != nil {
+			return "", fmt.Errorf("error exporting plan %s: %v", planID, err)
+		}
+	}
+
+	// The mocks are generated asynchronously, so wait for the export to be
+	// finished before downloading it.
+	if pe.Status != tfe.PlanExportFinished {
+		err = resource.Retry(time.Duration(5)*time.Minute, func() *resource.RetryError {
+			log.Printf("[DEBUG] Read status of plan export: %s", pe.ID)
+			v, err := tfeClient.PlanExports.Read(ctx, pe.ID)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			switch v.Status {
+			case tfe.PlanExportFinished:
+				return nil
+			case tfe.PlanExportPending, tfe.PlanExportQueued:
+				return resource.RetryableError(
+					fmt.Errorf("plan export %s is still %s", v.ID, v.Status))
+			default:
+				return resource.NonRetryableError(
+					fmt.Errorf("plan export %s is %s", v.ID, v.Status))
+			}
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	log.Printf("[DEBUG] Download plan export: %s", pe.ID)
+	bundle, err := tfeClient.PlanExports.Download(ctx, pe.ID)
+	if err != nil {
+		return "", fmt.Errorf("error downloading plan export %s: %v", pe.ID, err)
+	}
+
+	if err := os.MkdirAll(outputPath, 0755); err != nil {
+		return "", fmt.Errorf("error creating directory %s: %v", outputPath, err)
+	}
+
+	if err := slug.Unpack(bytes.NewReader(bundle), outputPath); err != nil {
+		return "", fmt.Errorf("error extracting plan export %s to %s: %v", pe.ID, outputPath, err)
+	}
+
+	return pe.ID, nil
+}
+
+// findSentinelMocksExport returns a Sentinel mock export of the plan that is
+// finished or still being generated, or nil when the plan has none.
+func findSentinelMocksExport(tfeClient *tfe.Client, planID string) (*tfe.PlanExport, error) {
+	log.Printf("[DEBUG] Read plan: %s", planID)
+	plan, err := tfeClient.Plans.Read(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading plan %s: %v", planID, err)
+	}
+
+	// The plan only includes the IDs of its exports.
+	for _, export := range plan.Exports {
+		log.Printf("[DEBUG] Read plan export: %s", export.ID)
+		pe, err := tfeClient.PlanExports.Read(ctx, export.ID)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("error reading plan export %s: %v", export.ID, err)
+		}
+
+		if pe.DataType != tfe.PlanExportSentinelMockBundleV0 {
+			continue
+		}
+
+		switch pe.Status {
+		case tfe.PlanExportFinished, tfe.PlanExportPending, tfe.PlanExportQueued:
+			return pe, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	slug "github.com/hashicorp/go-slug"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFESentinelMocksDataSource_noRuns(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFESentinelMocksDataSourceConfig(rInt, t.TempDir()),
+				ExpectError: regexp.MustCompile(`has no runs`),
+			},
+		},
+	})
+}
+
+func TestDownloadSentinelMocks(t *testing.T) {
+	mocks := map[string]string{
+		"mock-tfplan-v2.sentinel": "resource_changes = {}\n",
+		"sentinel.hcl":            "mock \"tfplan/v2\" {}\n",
+	}
+
+	src := t.TempDir()
+	for name, content := range mocks {
+		if err := os.WriteFile(filepath.Join(src, name), []byte(content), 0644); err != nil {
+			t.Fatalf("error writing %s: %v", name, err)
+		}
+	}
+
+	var bundle bytes.Buffer
+	if _, err := slug.Pack(src, &bundle, true); err != nil {
+		t.Fatalf("error packing mocks: %v", err)
+	}
+
+	planExports := map[string]*tfe.PlanExport{
+		"pe-expired": {
+			ID:       "pe-expired",
+			DataType: tfe.PlanExportSentinelMockBundleV0,
+			Status:   tfe.PlanExportExpired,
+		},
+		"pe-finished": {
+			ID:       "pe-finished",
+			DataType: tfe.PlanExportSentinelMockBundleV0,
+			Status:   tfe.PlanExportFinished,
+		},
+	}
+
+	cases := map[string]struct {
+		exports      []*tfe.PlanExport
+		planExportID string
+	}{
+		"reuses a finished export": {
+			exports:      []*tfe.PlanExport{{ID: "pe-expired"}, {ID: "pe-finished"}},
+			planExportID: "pe-finished",
+		},
+		"creates an export when none is available": {
+			exports:      []*tfe.PlanExport{{ID: "pe-expired"}},
+			planExportID: "pe-created-2",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testTfeClient(t, testClientOptions{
+				plans: map[string]*tfe.Plan{
+					"plan-123": {ID: "plan-123", Exports: tc.exports},
+				},
+				planExports:      planExports,
+				planExportBundle: bundle.Bytes(),
+			})
+
+			outputPath := filepath.Join(t.TempDir(), "mocks")
+			planExportID, err := downloadSentinelMocks(client, "plan-123", outputPath)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if planExportID != tc.planExportID {
+				t.Fatalf("expected plan export %s, got %s", tc.planExportID, planExportID)
+			}
+
+			for name, content := range mocks {
+				got, err := os.ReadFile(filepath.Join(outputPath, name))
+				if err != nil {
+					t.Fatalf("error reading %s: %v", name, err)
+				}
+				if string(got) != content {
+					t.Fatalf("expected %s to contain %q, got %q", name, content, got)
+				}
+			}
+		})
+	}
+}
+
+func testAccTFESentinelMocksDataSourceConfig(rInt int, outputPath string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_sentinel_mocks" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  output_path  = "%s"
+}`, rInt, outputPath)
+}
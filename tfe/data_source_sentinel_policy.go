@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFESentinelPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFESentinelPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enforce_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy_set_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	policy, err := fetchPolicyByName(tfeClient, organization, name)
+	if err != nil {
+		return err
+	}
+
+	d.Set("description", policy.Description)
+	d.Set("kind", string(policy.Kind))
+	d.Set("policy_set_count", policy.PolicySetCount)
+
+	enforceMode := ""
+	if len(policy.Enforce) == 1 {
+		enforceMode = string(policy.Enforce[0].Mode)
+	}
+	d.Set("enforce_mode", enforceMode)
+
+	d.SetId(policy.ID)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFESentinelPolicyDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESentinelPolicyDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_sentinel_policy.foobar", "id",
+						"tfe_sentinel_policy.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_sentinel_policy.foobar", "description", "A test policy"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_sentinel_policy.foobar", "kind", "sentinel"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_sentinel_policy.foobar", "enforce_mode", "hard-mandatory"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_sentinel_policy.foobar", "policy_set_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFESentinelPolicyDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_sentinel_policy" "foobar" {
+  name         = "policy-test"
+  description  = "A test policy"
+  organization = tfe_organization.foobar.id
+  policy       = "main = rule { true }"
+  enforce_mode = "hard-mandatory"
+}
+
+data "tfe_sentinel_policy" "foobar" {
+  name         = tfe_sentinel_policy.foobar.name
+  organization = tfe_organization.foobar.id
+}`, rInt)
+}
@@ -0,0 +1,154 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	tfe "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFETerraformVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFETerraformVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"version_constraint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := version.NewConstraint(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%q is not a valid version constraint: %v", k, err)}
+					}
+					return nil, nil
+				},
+			},
+
+			"include_beta": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"official": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"beta": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"deprecated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFETerraformVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	var constraints version.Constraints
+	if v, ok := d.GetOk("version_constraint"); ok {
+		c, err := version.NewConstraint(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing version constraint %q: %v", v, err)
+		}
+		constraints = c
+	}
+	includeBeta := d.Get("include_beta").(bool)
+
+	log.Printf("[DEBUG] Listing Terraform versions")
+	tfVersions, err := listTerraformVersions(tfeClient)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Terraform versions: %v", err)
+	}
+
+	type parsedVersion struct {
+		*terraformToolVersion
+		semver *version.Version
+	}
+
+	var matching []parsedVersion
+	for _, v := range tfVersions {
+		semver, err := version.NewVersion(v.Version)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping Terraform version %s: %v", v.Version, err)
+			continue
+		}
+
+		if v.Beta && !includeBeta {
+			continue
+		}
+
+		if constraints != nil && !constraints.Check(semver) {
+			continue
+		}
+
+		matching = append(matching, parsedVersion{v, semver})
+	}
+
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].semver.LessThan(matching[j].semver)
+	})
+
+	// The latest version is the highest matching version that can actually
+	// be used by a workspace.
+	latestVersion := ""
+	versions := make([]map[string]interface{}, 0, len(matching))
+	for _, v := range matching {
+		if v.Enabled && !v.Deprecated {
+			latestVersion = v.Version
+		}
+
+		versions = append(versions, map[string]interface{}{
+			"id":         v.ID,
+			"version":    v.Version,
+			"official":   v.Official,
+			"enabled":    v.Enabled,
+			"beta":       v.Beta,
+			"deprecated": v.Deprecated,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("terraform-versions/%s/%t", d.Get("version_constraint").(string), includeBeta))
+	d.Set("latest_version", latestVersion)
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting versions: %v", err)
+	}
+
+	return nil
+}
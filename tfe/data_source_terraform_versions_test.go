@@ -0,0 +1,58 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFETerraformVersionsDataSource_basic(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	sha := genSha(t, "secret", "data")
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	version := genVersion(rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETerraformVersionsDataSourceConfig(version, sha),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "latest_version", version),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_terraform_versions.foobar", "versions.0.id",
+						"tfe_terraform_version.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.0.version", version),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.0.beta", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.0.deprecated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFETerraformVersionsDataSourceConfig(version, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_terraform_version" "foobar" {
+  version = "%s"
+  url     = "https://www.hashicorp.com"
+  sha     = "%s"
+}
+
+data "tfe_terraform_versions" "foobar" {
+  version_constraint = "= ${tfe_terraform_version.foobar.version}"
+}`, version, sha)
+}
@@ -28,16 +28,61 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"allow_destroy_plan": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 
+			"assessments_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"auto_apply": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 
+			"drifted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"resources_drifted": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"resources_undrifted": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checks_passed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checks_failed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checks_errored": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checks_unknown": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"file_triggers_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -84,6 +129,31 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"current_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"current_run_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"current_state_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_applied_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"readme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"speculative_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -117,6 +187,12 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"trigger_patterns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"working_directory": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -146,6 +222,16 @@ func dataSourceTFEWorkspace() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"github_app_installation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tags_regex": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -161,7 +247,9 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	organization := d.Get("organization").(string)
 
 	log.Printf("[DEBUG] Read configuration of workspace: %s", name)
-	workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
+	workspace, err := tfeClient.Workspaces.ReadWithOptions(ctx, organization, name, &tfe.WorkspaceReadOptions{
+		Include: []tfe.WSIncludeOpt{tfe.WSCurrentRun},
+	})
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			return fmt.Errorf("could not find workspace %s/%s", organization, name)
@@ -170,6 +258,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	}
 	// Update the config.
 	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
+	d.Set("assessments_enabled", workspace.AssessmentsEnabled)
 	d.Set("auto_apply", workspace.AutoApply)
 	d.Set("description", workspace.Description)
 	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
@@ -183,6 +272,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("structured_run_output_enabled", workspace.StructuredRunOutputEnabled)
 	d.Set("terraform_version", workspace.TerraformVersion)
 	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
+	d.Set("trigger_patterns", workspace.TriggerPatterns)
 	d.Set("working_directory", workspace.WorkingDirectory)
 
 	// Set remote_state_consumer_ids if global_remote_state is false
@@ -210,6 +300,58 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("ssh_key_id", workspace.SSHKey.ID)
 	}
 
+	if workspace.Project != nil {
+		d.Set("project_id", workspace.Project.ID)
+	}
+
+	// Update the latest health assessment result, if any. Health assessments
+	// are not available to every organization, so a missing assessment or
+	// entitlement leaves the assessment attributes empty.
+	assessmentResult, err := readWorkspaceCurrentAssessmentResult(workspace.ID, tfeClient)
+	if err != nil {
+		return fmt.Errorf(
+			"Error reading current assessment result for workspace %s: %v", workspace.ID, err)
+	}
+	if assessmentResult == nil {
+		assessmentResult = &assessmentResultSummary{}
+	}
+	d.Set("drifted", assessmentResult.Drifted)
+	d.Set("resources_drifted", assessmentResult.ResourcesDrifted)
+	d.Set("resources_undrifted", assessmentResult.ResourcesUndrifted)
+	d.Set("checks_passed", assessmentResult.ChecksPassed)
+	d.Set("checks_failed", assessmentResult.ChecksFailed)
+	d.Set("checks_errored", assessmentResult.ChecksErrored)
+	d.Set("checks_unknown", assessmentResult.ChecksUnknown)
+
+	// Update the current run and state version, if any
+	var currentRunID, currentRunStatus string
+	if workspace.CurrentRun != nil {
+		currentRunID = workspace.CurrentRun.ID
+		currentRunStatus = string(workspace.CurrentRun.Status)
+	}
+	d.Set("current_run_id", currentRunID)
+	d.Set("current_run_status", currentRunStatus)
+
+	var currentStateVersionID string
+	if workspace.CurrentStateVersion != nil {
+		currentStateVersionID = workspace.CurrentStateVersion.ID
+	}
+	d.Set("current_state_version_id", currentStateVersionID)
+
+	lastAppliedAt, err := readWorkspaceLastAppliedAt(workspace.ID, tfeClient)
+	if err != nil {
+		return fmt.Errorf(
+			"Error reading last applied run for workspace %s: %v", workspace.ID, err)
+	}
+	d.Set("last_applied_at", lastAppliedAt)
+
+	log.Printf("[DEBUG] Read README of workspace: %s", workspace.ID)
+	readme, err := readWorkspaceReadme(workspace.ID, tfeClient)
+	if err != nil {
+		return fmt.Errorf("Error reading README for workspace %s: %v", workspace.ID, err)
+	}
+	d.Set("readme", readme)
+
 	// Update the tag names
 	var tagNames []interface{}
 	for _, tagName := range workspace.TagNames {
@@ -220,10 +362,12 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	var vcsRepo []interface{}
 	if workspace.VCSRepo != nil {
 		vcsConfig := map[string]interface{}{
-			"identifier":         workspace.VCSRepo.Identifier,
-			"branch":             workspace.VCSRepo.Branch,
-			"ingress_submodules": workspace.VCSRepo.IngressSubmodules,
-			"oauth_token_id":     workspace.VCSRepo.OAuthTokenID,
+			"identifier":                 workspace.VCSRepo.Identifier,
+			"branch":                     workspace.VCSRepo.Branch,
+			"ingress_submodules":         workspace.VCSRepo.IngressSubmodules,
+			"oauth_token_id":             workspace.VCSRepo.OAuthTokenID,
+			"github_app_installation_id": workspace.VCSRepo.GHAInstallationID,
+			"tags_regex":                 workspace.VCSRepo.TagsRegex,
 		}
 		vcsRepo = append(vcsRepo, vcsConfig)
 	}
@@ -0,0 +1,369 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	workspaceGraphEdgeRunTrigger  = "run_trigger"
+	workspaceGraphEdgeRemoteState = "remote_state"
+)
+
+// workspaceGraphEdge is a dependency between two workspaces, pointing from
+// the upstream workspace to the workspace that depends on it.
+type workspaceGraphEdge struct {
+	Source string
+	Target string
+	Type   string
+}
+
+func dataSourceTFEWorkspaceGraph() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEWorkspaceGraphRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag_names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+
+			"render_dot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"edges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"has_cycles": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"cycles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeList,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+
+			"dot": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFEWorkspaceGraphRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the organization.
+	organization := d.Get("organization").(string)
+
+	// Build the state ID from the project and tag names we are looking for.
+	projectID := d.Get("project_id").(string)
+	id := projectID
+
+	var tagNames []string
+	for _, tagName := range d.Get("tag_names").([]interface{}) {
+		if tagName == nil || len(strings.TrimSpace(tagName.(string))) == 0 {
+			continue
+		}
+		id += tagName.(string)
+		tagNames = append(tagNames, tagName.(string))
+	}
+
+	log.Printf("[DEBUG] Read workspaces of organization: %s", organization)
+	workspaces, err := listWorkspacesBySelector(tfeClient, organization, []string{"*"}, tagNames, projectID)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]string, len(workspaces))
+	for _, w := range workspaces {
+		names[w.ID] = w.Name
+	}
+
+	var edges []workspaceGraphEdge
+	for _, w := range workspaces {
+		log.Printf("[DEBUG] Read run triggers of workspace: %s", w.ID)
+		runTriggers, err := listInboundRunTriggers(tfeClient, w.ID)
+		if err != nil {
+			return fmt.Errorf("Error reading run triggers of workspace %s: %v", w.ID, err)
+		}
+
+		for sourceableID := range runTriggers {
+			// Only keep the dependencies between the selected workspaces.
+			if _, ok := names[sourceableID]; ok {
+				edges = append(edges, workspaceGraphEdge{
+					Source: sourceableID,
+					Target: w.ID,
+					Type:   workspaceGraphEdgeRunTrigger,
+				})
+			}
+		}
+
+		// Any workspace can access the state of a workspace with global remote
+		// state, so that doesn't tell anything about its dependents.
+		if w.GlobalRemoteState {
+			continue
+		}
+
+		log.Printf("[DEBUG] Read remote state consumers of workspace: %s", w.ID)
+		_, consumerIDs, err := readWorkspaceStateConsumers(w.ID, tfeClient)
+		if err != nil {
+			return fmt.Errorf(
+				"Error reading remote state consumers of workspace %s: %v", w.ID, err)
+		}
+
+		for _, consumerID := range consumerIDs {
+			if _, ok := names[consumerID.(string)]; ok {
+				edges = append(edges, workspaceGraphEdge{
+					Source: w.ID,
+					Target: consumerID.(string),
+					Type:   workspaceGraphEdgeRemoteState,
+				})
+			}
+		}
+	}
+
+	sortWorkspaceGraphEdges(edges, names)
+
+	var nodes []interface{}
+	for _, w := range sortedWorkspaceIDs(names) {
+		nodes = append(nodes, map[string]interface{}{
+			"id":   w,
+			"name": names[w],
+		})
+	}
+
+	var edgeList []interface{}
+	for _, e := range edges {
+		edgeList = append(edgeList, map[string]interface{}{
+			"source_id": e.Source,
+			"target_id": e.Target,
+			"type":      e.Type,
+		})
+	}
+
+	cycles := findWorkspaceGraphCycles(sortedWorkspaceIDs(names), edges)
+
+	var cycleList []interface{}
+	for _, cycle := range cycles {
+		var ids []interface{}
+		for _, id := range cycle {
+			ids = append(ids, id)
+		}
+		cycleList = append(cycleList, ids)
+	}
+
+	d.Set("nodes", nodes)
+	d.Set("edges", edgeList)
+	d.Set("has_cycles", len(cycles) > 0)
+	d.Set("cycles", cycleList)
+
+	if d.Get("render_dot").(bool) {
+		d.Set("dot", renderWorkspaceGraphDOT(organization, names, edges))
+	} else {
+		d.Set("dot", "")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", organization, schema.HashString(id)))
+
+	return nil
+}
+
+// sortedWorkspaceIDs returns the IDs of the given workspaces, ordered by
+// their names.
+func sortedWorkspaceIDs(names map[string]string) []string {
+	ids := make([]string, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if names[ids[i]] != names[ids[j]] {
+			return names[ids[i]] < names[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+// sortWorkspaceGraphEdges orders the edges by the names of their workspaces
+// so the results are stable between reads.
+func sortWorkspaceGraphEdges(edges []workspaceGraphEdge, names map[string]string) {
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if names[a.Source] != names[b.Source] {
+			return names[a.Source] < names[b.Source]
+		}
+		if names[a.Target] != names[b.Target] {
+			return names[a.Target] < names[b.Target]
+		}
+		return a.Type < b.Type
+	})
+}
+
+// findWorkspaceGraphCycles returns the strongly connected components of the
+// graph that contain a cycle, each as the list of the workspace IDs that are
+// part of it. The nodes of each cycle keep the order of the given nodes.
+func findWorkspaceGraphCycles(nodes []string, edges []workspaceGraphEdge) [][]string {
+	order := make(map[string]int, len(nodes))
+	for i, n := range nodes {
+		order[n] = i
+	}
+
+	adjacent := make(map[string][]string)
+	selfLoops := make(map[string]bool)
+	for _, e := range edges {
+		adjacent[e.Source] = append(adjacent[e.Source], e.Target)
+		if e.Source == e.Target {
+			selfLoops[e.Source] = true
+		}
+	}
+
+	// Tarjan's strongly connected components algorithm.
+	index := 0
+	indices := make(map[string]int)
+	lowlinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+
+	var connect func(n string)
+	connect = func(n string) {
+		indices[n] = index
+		lowlinks[n] = index
+		index++
+		stack = append(stack, n)
+		onStack[n] = true
+
+		for _, m := range adjacent[n] {
+			if _, ok := indices[m]; !ok {
+				connect(m)
+				if lowlinks[m] < lowlinks[n] {
+					lowlinks[n] = lowlinks[m]
+				}
+			} else if onStack[m] && indices[m] < lowlinks[n] {
+				lowlinks[n] = indices[m]
+			}
+		}
+
+		if lowlinks[n] != indices[n] {
+			return
+		}
+
+		var component []string
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			component = append(component, m)
+			if m == n {
+				break
+			}
+		}
+
+		if len(component) > 1 || selfLoops[n] {
+			sort.Slice(component, func(i, j int) bool {
+				return order[component[i]] < order[component[j]]
+			})
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, n := range nodes {
+		if _, ok := indices[n]; !ok {
+			connect(n)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return order[cycles[i][0]] < order[cycles[j][0]]
+	})
+
+	return cycles
+}
+
+// renderWorkspaceGraphDOT renders the graph in the Graphviz DOT language,
+// using the workspace names as node identifiers.
+func renderWorkspaceGraphDOT(organization string, names map[string]string, edges []workspaceGraphEdge) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %q {\n", organization)
+	for _, id := range sortedWorkspaceIDs(names) {
+		fmt.Fprintf(&b, "  %q [id=%q];\n", names[id], id)
+	}
+	for _, e := range edges {
+		style := "solid"
+		if e.Type == workspaceGraphEdgeRemoteState {
+			style = "dashed"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q, style=%s];\n",
+			names[e.Source], names[e.Target], e.Type, style)
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
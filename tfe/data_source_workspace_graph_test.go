@@ -0,0 +1,184 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceGraphDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceGraphDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "nodes.#", "3"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_graph.foobar", "nodes.0.id", "tfe_workspace.a", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "nodes.0.name", "workspace-a"),
+
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "edges.#", "3"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_graph.foobar", "edges.0.source_id", "tfe_workspace.a", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_graph.foobar", "edges.0.target_id", "tfe_workspace.b", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "edges.0.type", "run_trigger"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "edges.1.type", "remote_state"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "edges.2.type", "run_trigger"),
+
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "has_cycles", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "cycles.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_graph.foobar", "cycles.0.#", "2"),
+
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_graph.foobar", "dot"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindWorkspaceGraphCycles(t *testing.T) {
+	cases := map[string]struct {
+		nodes  []string
+		edges  []workspaceGraphEdge
+		cycles [][]string
+	}{
+		"no edges": {
+			nodes:  []string{"ws-a", "ws-b"},
+			cycles: nil,
+		},
+		"chain": {
+			nodes: []string{"ws-a", "ws-b", "ws-c"},
+			edges: []workspaceGraphEdge{
+				{Source: "ws-a", Target: "ws-b"},
+				{Source: "ws-b", Target: "ws-c"},
+			},
+			cycles: nil,
+		},
+		"diamond": {
+			nodes: []string{"ws-a", "ws-b", "ws-c", "ws-d"},
+			edges: []workspaceGraphEdge{
+				{Source: "ws-a", Target: "ws-b"},
+				{Source: "ws-a", Target: "ws-c"},
+				{Source: "ws-b", Target: "ws-d"},
+				{Source: "ws-c", Target: "ws-d"},
+			},
+			cycles: nil,
+		},
+		"self loop": {
+			nodes: []string{"ws-a", "ws-b"},
+			edges: []workspaceGraphEdge{
+				{Source: "ws-a", Target: "ws-b"},
+				{Source: "ws-b", Target: "ws-b"},
+			},
+			cycles: [][]string{{"ws-b"}},
+		},
+		"multiple cycles": {
+			nodes: []string{"ws-a", "ws-b", "ws-c", "ws-d", "ws-e"},
+			edges: []workspaceGraphEdge{
+				{Source: "ws-c", Target: "ws-a"},
+				{Source: "ws-a", Target: "ws-b"},
+				{Source: "ws-b", Target: "ws-c"},
+				{Source: "ws-c", Target: "ws-d"},
+				{Source: "ws-d", Target: "ws-e"},
+				{Source: "ws-e", Target: "ws-d"},
+			},
+			cycles: [][]string{{"ws-a", "ws-b", "ws-c"}, {"ws-d", "ws-e"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cycles := findWorkspaceGraphCycles(tc.nodes, tc.edges)
+			if !reflect.DeepEqual(cycles, tc.cycles) {
+				t.Fatalf("expected cycles %v, got %v", tc.cycles, cycles)
+			}
+		})
+	}
+}
+
+func TestRenderWorkspaceGraphDOT(t *testing.T) {
+	names := map[string]string{
+		"ws-2": "network",
+		"ws-1": "app",
+	}
+	edges := []workspaceGraphEdge{
+		{Source: "ws-2", Target: "ws-1", Type: workspaceGraphEdgeRunTrigger},
+		{Source: "ws-2", Target: "ws-1", Type: workspaceGraphEdgeRemoteState},
+	}
+
+	expected := `digraph "my-org" {
+  "app" [id="ws-1"];
+  "network" [id="ws-2"];
+  "network" -> "app" [label="run_trigger", style=solid];
+  "network" -> "app" [label="remote_state", style=dashed];
+}
+`
+
+	if dot := renderWorkspaceGraphDOT("my-org", names, edges); dot != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, dot)
+	}
+}
+
+func testAccTFEWorkspaceGraphDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "a" {
+  name                      = "workspace-a"
+  organization              = tfe_organization.foobar.id
+  global_remote_state       = false
+  remote_state_consumer_ids = [tfe_workspace.c.id]
+}
+
+resource "tfe_workspace" "b" {
+  name         = "workspace-b"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "c" {
+  name         = "workspace-c"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_run_trigger" "a_to_b" {
+  workspace_id  = tfe_workspace.b.id
+  sourceable_id = tfe_workspace.a.id
+}
+
+resource "tfe_run_trigger" "c_to_a" {
+  workspace_id  = tfe_workspace.a.id
+  sourceable_id = tfe_workspace.c.id
+}
+
+data "tfe_workspace_graph" "foobar" {
+  organization = tfe_organization.foobar.name
+  render_dot   = true
+
+  depends_on = [
+    tfe_run_trigger.a_to_b,
+    tfe_run_trigger.c_to_a,
+  ]
+}`, rInt)
+}
@@ -26,6 +26,11 @@ func dataSourceTFEWorkspaceIDs() *schema.Resource {
 				Optional: true,
 			},
 
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"organization": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,9 +55,9 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 	// Get the organization.
 	organization := d.Get("organization").(string)
 
-	// Create a map with all the names we are looking for.
+	// Build the state ID from all the names and tag names we are looking for.
 	var id string
-	names := make(map[string]bool)
+	var names []string
 	for _, name := range d.Get("names").([]interface{}) {
 		// ignore empty strings
 		if name == nil {
@@ -60,53 +65,34 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 		}
 
 		id += name.(string)
-		names[name.(string)] = true
+		names = append(names, name.(string))
 	}
-	isWildcard := names["*"]
-
-	// Create two maps to hold the results.
-	fullNames := make(map[string]string, len(names))
-	ids := make(map[string]string, len(names))
-
-	options := &tfe.WorkspaceListOptions{}
 
-	// Create a search string with all the tag names we are looking for.
-	var tagSearchParts []string
+	var tagNames []string
 	for _, tagName := range d.Get("tag_names").([]interface{}) {
 		name := tagName.(string)
 		if len(strings.TrimSpace(name)) != 0 {
 			id += name // add to the state id
-			tagSearchParts = append(tagSearchParts, name)
+			tagNames = append(tagNames, name)
 		}
 	}
-	if len(tagSearchParts) > 0 {
-		tagSearch := strings.Join(tagSearchParts, ",")
-		options.Tags = tagSearch
-	}
-
-	hasOnlyTags := len(tagSearchParts) > 0 && len(names) == 0
 
-	for {
-		wl, err := tfeClient.Workspaces.List(ctx, organization, options)
-		if err != nil {
-			return fmt.Errorf("Error retrieving workspaces: %v", err)
-		}
+	// Restrict the results to a single project, if one is configured.
+	projectID := d.Get("project_id").(string)
+	id += projectID
 
-		for _, w := range wl.Items {
-			nameIncluded := isWildcard || names[w.Name]
-			if hasOnlyTags || nameIncluded {
-				fullNames[w.Name] = organization + "/" + w.Name
-				ids[w.Name] = w.ID
-			}
-		}
+	workspaces, err := listWorkspacesBySelector(tfeClient, organization, names, tagNames, projectID)
+	if err != nil {
+		return err
+	}
 
-		// Exit the loop when we've seen all pages.
-		if wl.CurrentPage >= wl.TotalPages {
-			break
-		}
+	// Create two maps to hold the results.
+	fullNames := make(map[string]string, len(workspaces))
+	ids := make(map[string]string, len(workspaces))
 
-		// Update the page number to get the next page.
-		options.PageNumber = wl.NextPage
+	for _, w := range workspaces {
+		fullNames[w.Name] = organization + "/" + w.Name
+		ids[w.Name] = w.ID
 	}
 
 	d.Set("ids", ids)
@@ -263,6 +263,27 @@ func TestAccTFEWorkspaceIDsDataSource_namesEmpty(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspaceIDsDataSource_project(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceIDsDataSourceConfig_project(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.foobar", "ids.%", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_ids.foobar", fmt.Sprintf("ids.workspace-foo-%d", rInt),
+						"tfe_workspace.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTFEWorkspaceIDsDataSourceConfig_basic(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -427,3 +448,35 @@ data "tfe_workspace_ids" "good" {
   organization = tfe_workspace.foo.organization
 }`, rInt, rInt, rInt, rInt)
 }
+
+func testAccTFEWorkspaceIDsDataSourceConfig_project(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo-%d"
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+}
+
+resource "tfe_workspace" "bar" {
+  name         = "workspace-bar-%d"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_workspace_ids" "foobar" {
+  names        = ["*"]
+  organization = tfe_organization.foobar.id
+  project_id   = tfe_project.foobar.id
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar]
+}`, rInt, rInt, rInt)
+}
@@ -74,8 +74,16 @@ func TestAccTFEWorkspaceDataSource_basic(t *testing.T) {
 						"data.tfe_workspace.foobar", "global_remote_state", "true"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "allow_destroy_plan", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "assessments_enabled", "false"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "auto_apply", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "drifted", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "resources_drifted", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "checks_failed", "0"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "file_triggers_enabled", "true"),
 					resource.TestCheckResourceAttr(
@@ -88,6 +96,16 @@ func TestAccTFEWorkspaceDataSource_basic(t *testing.T) {
 						"data.tfe_workspace.foobar", "run_failures", "0"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "runs_count", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "current_run_id", ""),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "current_run_status", ""),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "current_state_version_id", ""),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "last_applied_at", ""),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.foobar", "readme", ""),
 					resource.TestCheckResourceAttr(
 						"data.tfe_workspace.foobar", "speculative_enabled", "true"),
 					resource.TestCheckResourceAttr(
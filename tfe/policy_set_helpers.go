@@ -0,0 +1,134 @@
+package tfe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// bundledPolicy represents a Sentinel policy, and the Sentinel modules it
+// imports, that is bundled into a versioned policy set.
+type bundledPolicy struct {
+	Name             string
+	EnforcementLevel string
+	SourceFile       string
+	Modules          map[string]string
+}
+
+// sentinelContentHash returns a hash of the content of a Sentinel policy and
+// of the content of the module files it imports, so changes to any of those
+// files can be detected.
+func sentinelContentHash(policy string, modules map[string]string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "policy:%d:%s\n", len(policy), policy)
+
+	// Sort the modules to get a stable hash.
+	var names []string
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content, err := os.ReadFile(modules[name])
+		if err != nil {
+			return "", fmt.Errorf("error reading module %s: %w", name, err)
+		}
+		fmt.Fprintf(h, "module:%s:%d:%s\n", name, len(content), content)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// bundledPoliciesHash returns a hash of the configuration and the content of
+// the files of the given bundled policies.
+func bundledPoliciesHash(policies []bundledPolicy) (string, error) {
+	h := sha256.New()
+	for _, policy := range policies {
+		content, err := os.ReadFile(policy.SourceFile)
+		if err != nil {
+			return "", fmt.Errorf("error reading policy %s: %w", policy.Name, err)
+		}
+
+		contentHash, err := sentinelContentHash(string(content), policy.Modules)
+		if err != nil {
+			return "", fmt.Errorf("error reading policy %s: %w", policy.Name, err)
+		}
+
+		fmt.Fprintf(h, "%s:%s:%s\n", policy.Name, policy.EnforcementLevel, contentHash)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writePolicySetBundle writes the given policies and their modules into dir,
+// together with a generated sentinel.hcl configuration, so the directory can
+// be uploaded as a policy set version.
+func writePolicySetBundle(dir string, policies []bundledPolicy) error {
+	var config strings.Builder
+
+	// Modules are shared by all policies in the bundle, so the same module
+	// name must always refer to the same file.
+	modules := make(map[string]string)
+	var moduleNames []string
+	for _, policy := range policies {
+		for name, source := range policy.Modules {
+			if existing, ok := modules[name]; ok {
+				if existing != source {
+					return fmt.Errorf("module %s refers to both %s and %s", name, existing, source)
+				}
+				continue
+			}
+			modules[name] = source
+			moduleNames = append(moduleNames, name)
+		}
+	}
+	sort.Strings(moduleNames)
+
+	if len(moduleNames) > 0 {
+		if err := os.MkdirAll(filepath.Join(dir, "modules"), 0755); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range moduleNames {
+		path := filepath.Join("modules", name+".sentinel")
+		if err := copyFile(modules[name], filepath.Join(dir, path)); err != nil {
+			return fmt.Errorf("error copying module %s: %w", name, err)
+		}
+
+		fmt.Fprintf(&config, "module %q {\n  source = %q\n}\n\n", name, "./"+filepath.ToSlash(path))
+	}
+
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if seen[policy.Name] {
+			return fmt.Errorf("policy %s is bundled more than once", policy.Name)
+		}
+		seen[policy.Name] = true
+
+		path := policy.Name + ".sentinel"
+		if err := copyFile(policy.SourceFile, filepath.Join(dir, path)); err != nil {
+			return fmt.Errorf("error copying policy %s: %w", policy.Name, err)
+		}
+
+		fmt.Fprintf(&config, "policy %q {\n  source            = %q\n  enforcement_level = %q\n}\n\n",
+			policy.Name, "./"+path, policy.EnforcementLevel)
+	}
+
+	return os.WriteFile(filepath.Join(dir, "sentinel.hcl"), []byte(config.String()), 0644)
+}
+
+// copyFile copies the content of the src file to the dst file.
+func copyFile(src, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, content, 0644)
+}
@@ -0,0 +1,98 @@
+package tfe
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSentinelContentHash(t *testing.T) {
+	dir := t.TempDir()
+	module := filepath.Join(dir, "tags.sentinel")
+	if err := os.WriteFile(module, []byte("required = []"), 0644); err != nil {
+		t.Fatalf("error writing module: %v", err)
+	}
+
+	modules := map[string]string{"tags": module}
+	policy := "main = rule { true }"
+
+	original, err := sentinelContentHash(policy, modules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	withoutModules, err := sentinelContentHash(policy, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if withoutModules == original {
+		t.Fatalf("expected the hash to depend on the modules")
+	}
+
+	if err := os.WriteFile(module, []byte(`required = ["owner"]`), 0644); err != nil {
+		t.Fatalf("error writing module: %v", err)
+	}
+
+	updated, err := sentinelContentHash(policy, modules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == original {
+		t.Fatalf("expected the hash to change when a module file changes")
+	}
+
+	if _, err := sentinelContentHash(policy, map[string]string{"missing": filepath.Join(dir, "missing")}); err == nil {
+		t.Fatalf("expected an error for a missing module file")
+	}
+}
+
+func TestWritePolicySetBundle(t *testing.T) {
+	fixtures := "test-fixtures/sentinel-modules"
+	policy := bundledPolicy{
+		Name:             "require-tags",
+		EnforcementLevel: "hard-mandatory",
+		SourceFile:       filepath.Join(fixtures, "require-tags.sentinel"),
+		Modules:          map[string]string{"tags": filepath.Join(fixtures, "modules", "tags.sentinel")},
+	}
+
+	dir := t.TempDir()
+	if err := writePolicySetBundle(dir, []bundledPolicy{policy}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config, err := os.ReadFile(filepath.Join(dir, "sentinel.hcl"))
+	if err != nil {
+		t.Fatalf("error reading sentinel.hcl: %v", err)
+	}
+
+	expected := `module "tags" {
+  source = "./modules/tags.sentinel"
+}
+
+policy "require-tags" {
+  source            = "./require-tags.sentinel"
+  enforcement_level = "hard-mandatory"
+}
+`
+	if strings.TrimSpace(string(config)) != strings.TrimSpace(expected) {
+		t.Fatalf("expected sentinel.hcl:\n%s\ngot:\n%s", expected, config)
+	}
+
+	for _, path := range []string{"require-tags.sentinel", filepath.Join("modules", "tags.sentinel")} {
+		if _, err := os.Stat(filepath.Join(dir, path)); err != nil {
+			t.Fatalf("expected %s to be bundled: %v", path, err)
+		}
+	}
+
+	conflicting := policy
+	conflicting.Name = "other"
+	conflicting.Modules = map[string]string{"tags": conflicting.SourceFile}
+	if err := writePolicySetBundle(t.TempDir(), []bundledPolicy{policy, conflicting}); err == nil {
+		t.Fatalf("expected an error for a module referring to different files")
+	}
+
+	if err := writePolicySetBundle(t.TempDir(), []bundledPolicy{policy, policy}); err == nil {
+		t.Fatalf("expected an error for a policy bundled twice")
+	}
+}
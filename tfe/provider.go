@@ -50,7 +50,7 @@ var ctx = context.Background()
 
 // Provider returns a schema.Provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		// Note that defaults and fallbacks which are usually handled by DefaultFunc here are
 		// instead handled when fetching a TFC/E client in getClient(). This is because the this
 		// provider is actually two muxed providers which must respect the same logic for fetching
@@ -78,49 +78,82 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"tfe_organizations":           dataSourceTFEOrganizations(),
 			"tfe_organization":            dataSourceTFEOrganization(),
+			"tfe_admin_users":             dataSourceTFEAdminUsers(),
 			"tfe_agent_pool":              dataSourceTFEAgentPool(),
+			"tfe_github_app_installation": dataSourceTFEGHAInstallation(),
 			"tfe_ip_ranges":               dataSourceTFEIPRanges(),
 			"tfe_oauth_client":            dataSourceTFEOAuthClient(),
 			"tfe_organization_membership": dataSourceTFEOrganizationMembership(),
+			"tfe_policy_checks":           dataSourceTFEPolicyChecks(),
+			"tfe_policy_set":              dataSourceTFEPolicySet(),
+			"tfe_project":                 dataSourceTFEProject(),
+			"tfe_sentinel_mocks":          dataSourceTFESentinelMocks(),
+			"tfe_sentinel_policy":         dataSourceTFESentinelPolicy(),
 			"tfe_slug":                    dataSourceTFESlug(),
 			"tfe_ssh_key":                 dataSourceTFESSHKey(),
 			"tfe_team":                    dataSourceTFETeam(),
 			"tfe_team_access":             dataSourceTFETeamAccess(),
+			"tfe_terraform_versions":      dataSourceTFETerraformVersions(),
 			"tfe_workspace":               dataSourceTFEWorkspace(),
 			"tfe_workspace_ids":           dataSourceTFEWorkspaceIDs(),
+			"tfe_workspace_graph":         dataSourceTFEWorkspaceGraph(),
 			"tfe_variables":               dataSourceTFEWorkspaceVariables(),
 			"tfe_variable_set":            dataSourceTFEVariableSet(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"tfe_agent_pool":                  resourceTFEAgentPool(),
-			"tfe_agent_token":                 resourceTFEAgentToken(),
-			"tfe_notification_configuration":  resourceTFENotificationConfiguration(),
-			"tfe_oauth_client":                resourceTFEOAuthClient(),
-			"tfe_organization":                resourceTFEOrganization(),
-			"tfe_organization_membership":     resourceTFEOrganizationMembership(),
-			"tfe_organization_module_sharing": resourceTFEOrganizationModuleSharing(),
-			"tfe_organization_token":          resourceTFEOrganizationToken(),
-			"tfe_policy_set":                  resourceTFEPolicySet(),
-			"tfe_policy_set_parameter":        resourceTFEPolicySetParameter(),
-			"tfe_registry_module":             resourceTFERegistryModule(),
-			"tfe_run_trigger":                 resourceTFERunTrigger(),
-			"tfe_sentinel_policy":             resourceTFESentinelPolicy(),
-			"tfe_ssh_key":                     resourceTFESSHKey(),
-			"tfe_team":                        resourceTFETeam(),
-			"tfe_team_access":                 resourceTFETeamAccess(),
-			"tfe_team_organization_member":    resourceTFETeamOrganizationMember(),
-			"tfe_team_member":                 resourceTFETeamMember(),
-			"tfe_team_members":                resourceTFETeamMembers(),
-			"tfe_team_token":                  resourceTFETeamToken(),
-			"tfe_terraform_version":           resourceTFETerraformVersion(),
-			"tfe_workspace":                   resourceTFEWorkspace(),
-			"tfe_variable":                    resourceTFEVariable(),
-			"tfe_variable_set":                resourceTFEVariableSet(),
+			"tfe_admin_cost_estimation_settings": resourceTFEAdminCostEstimationSettings(),
+			"tfe_admin_customization_settings":   resourceTFEAdminCustomizationSettings(),
+			"tfe_admin_general_settings":         resourceTFEAdminGeneralSettings(),
+			"tfe_admin_organization_settings":    resourceTFEAdminOrganizationSettings(),
+			"tfe_admin_saml_settings":            resourceTFEAdminSAMLSettings(),
+			"tfe_admin_smtp_settings":            resourceTFEAdminSMTPSettings(),
+			"tfe_admin_twilio_settings":          resourceTFEAdminTwilioSettings(),
+			"tfe_admin_user":                     resourceTFEAdminUser(),
+			"tfe_agent_pool":                     resourceTFEAgentPool(),
+			"tfe_agent_token":                    resourceTFEAgentToken(),
+			"tfe_notification_configuration":     resourceTFENotificationConfiguration(),
+			"tfe_oauth_client":                   resourceTFEOAuthClient(),
+			"tfe_opa_version":                    resourceTFEOPAVersion(),
+			"tfe_organization":                   resourceTFEOrganization(),
+			"tfe_organization_membership":        resourceTFEOrganizationMembership(),
+			"tfe_organization_module_sharing":    resourceTFEOrganizationModuleSharing(),
+			"tfe_organization_token":             resourceTFEOrganizationToken(),
+			"tfe_policy_set":                     resourceTFEPolicySet(),
+			"tfe_policy_set_parameter":           resourceTFEPolicySetParameter(),
+			"tfe_project":                        resourceTFEProject(),
+			"tfe_registry_module":                resourceTFERegistryModule(),
+			"tfe_run_trigger":                    resourceTFERunTrigger(),
+			"tfe_run_triggers":                   resourceTFERunTriggers(),
+			"tfe_sentinel_policy":                resourceTFESentinelPolicy(),
+			"tfe_sentinel_version":               resourceTFESentinelVersion(),
+			"tfe_ssh_key":                        resourceTFESSHKey(),
+			"tfe_team":                           resourceTFETeam(),
+			"tfe_team_access":                    resourceTFETeamAccess(),
+			"tfe_team_access_policy":             resourceTFETeamAccessPolicy(),
+			"tfe_team_organization_member":       resourceTFETeamOrganizationMember(),
+			"tfe_team_organization_members":      resourceTFETeamOrganizationMembers(),
+			"tfe_team_member":                    resourceTFETeamMember(),
+			"tfe_team_members":                   resourceTFETeamMembers(),
+			"tfe_team_project_access":            resourceTFETeamProjectAccess(),
+			"tfe_team_token":                     resourceTFETeamToken(),
+			"tfe_terraform_version":              resourceTFETerraformVersion(),
+			"tfe_workspace":                      resourceTFEWorkspace(),
+			"tfe_workspace_clone":                resourceTFEWorkspaceClone(),
+			"tfe_workspace_settings_policy":      resourceTFEWorkspaceSettingsPolicy(),
+			"tfe_variable":                       resourceTFEVariable(),
+			"tfe_variable_set":                   resourceTFEVariableSet(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	// Deprecated Terraform versions are reported while validating the
+	// configuration, which needs the client of the configured provider.
+	provider.ResourcesMap["tfe_workspace"].Schema["terraform_version"].ValidateDiagFunc =
+		warnDeprecatedTerraformVersion(provider.Meta)
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -355,5 +355,7 @@ var GITHUB_POLICY_SET_IDENTIFIER = os.Getenv("GITHUB_POLICY_SET_IDENTIFIER")
 var GITHUB_POLICY_SET_BRANCH = os.Getenv("GITHUB_POLICY_SET_BRANCH")
 var GITHUB_POLICY_SET_PATH = os.Getenv("GITHUB_POLICY_SET_PATH")
 var GITHUB_REGISTRY_MODULE_IDENTIFIER = os.Getenv("GITHUB_REGISTRY_MODULE_IDENTIFIER")
+var GITHUB_APP_INSTALLATION_ID = os.Getenv("GITHUB_APP_INSTALLATION_ID")
+var GITHUB_APP_INSTALLATION_NAME = os.Getenv("GITHUB_APP_INSTALLATION_NAME")
 var TFE_USER1 = os.Getenv("TFE_USER1")
 var TFE_USER2 = os.Getenv("TFE_USER2")
@@ -0,0 +1,172 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adminCostEstimationSettingsDefaults are the cost estimation settings of a
+// fresh Terraform Enterprise installation. They are restored when the
+// resource is destroyed.
+var adminCostEstimationSettingsDefaults = tfe.AdminCostEstimationSettingOptions{
+	Enabled:             tfe.Bool(false),
+	AWSAccessKeyID:      tfe.String(""),
+	AWSAccessKey:        tfe.String(""),
+	GCPCredentials:      tfe.String(""),
+	AzureClientID:       tfe.String(""),
+	AzureClientSecret:   tfe.String(""),
+	AzureSubscriptionID: tfe.String(""),
+	AzureTenantID:       tfe.String(""),
+}
+
+func resourceTFEAdminCostEstimationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminCostEstimationSettingsUpdate,
+		Read:   resourceTFEAdminCostEstimationSettingsRead,
+		Update: resourceTFEAdminCostEstimationSettingsUpdate,
+		Delete: resourceTFEAdminCostEstimationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminCostEstimationSettingsDefaults.Enabled,
+			},
+
+			"aws_access_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"aws_secret_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"gcp_credentials": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"azure_client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"azure_client_secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"azure_subscription_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"azure_tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"aws_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"aws_instance_profile_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"gcp_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"azure_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminCostEstimationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read cost estimation settings")
+	settings, err := tfeClient.Admin.Settings.CostEstimation.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading cost estimation settings: %v", err)
+	}
+
+	// Update the config. Secrets are never returned by the API, so the
+	// configured values are kept.
+	d.Set("enabled", settings.Enabled)
+	d.Set("aws_access_key_id", settings.AWSAccessKeyID)
+	d.Set("azure_client_id", settings.AzureClientID)
+	d.Set("azure_subscription_id", settings.AzureSubscriptionID)
+	d.Set("azure_tenant_id", settings.AzureTenantID)
+	d.Set("aws_enabled", settings.AWSEnabled)
+	d.Set("aws_instance_profile_enabled", settings.AWSInstanceProfileEnabled)
+	d.Set("gcp_enabled", settings.GCPEnabled)
+	d.Set("azure_enabled", settings.AzureEnabled)
+
+	return nil
+}
+
+func resourceTFEAdminCostEstimationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminCostEstimationSettingOptions{
+		Enabled:             tfe.Bool(d.Get("enabled").(bool)),
+		AWSAccessKeyID:      tfe.String(d.Get("aws_access_key_id").(string)),
+		AzureClientID:       tfe.String(d.Get("azure_client_id").(string)),
+		AzureSubscriptionID: tfe.String(d.Get("azure_subscription_id").(string)),
+		AzureTenantID:       tfe.String(d.Get("azure_tenant_id").(string)),
+	}
+
+	if d.HasChange("aws_secret_key") {
+		options.AWSAccessKey = tfe.String(d.Get("aws_secret_key").(string))
+	}
+
+	if d.HasChange("gcp_credentials") {
+		options.GCPCredentials = tfe.String(d.Get("gcp_credentials").(string))
+	}
+
+	if d.HasChange("azure_client_secret") {
+		options.AzureClientSecret = tfe.String(d.Get("azure_client_secret").(string))
+	}
+
+	log.Printf("[DEBUG] Update cost estimation settings")
+	settings, err := tfeClient.Admin.Settings.CostEstimation.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating cost estimation settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFEAdminCostEstimationSettingsRead(d, meta)
+}
+
+func resourceTFEAdminCostEstimationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset cost estimation settings to their defaults")
+	_, err := tfeClient.Admin.Settings.CostEstimation.Update(ctx, adminCostEstimationSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting cost estimation settings: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adminCustomizationSettingsDefaults are the customization settings of a
+// fresh Terraform Enterprise installation. They are restored when the
+// resource is destroyed.
+var adminCustomizationSettingsDefaults = tfe.AdminCustomizationSettingsUpdateOptions{
+	SupportEmail: tfe.String("support@hashicorp.com"),
+	LoginHelp:    tfe.String(""),
+	Footer:       tfe.String(""),
+	Error:        tfe.String(""),
+	NewUser:      tfe.String(""),
+}
+
+func resourceTFEAdminCustomizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminCustomizationSettingsUpdate,
+		Read:   resourceTFEAdminCustomizationSettingsRead,
+		Update: resourceTFEAdminCustomizationSettingsUpdate,
+		Delete: resourceTFEAdminCustomizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"support_email_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  *adminCustomizationSettingsDefaults.SupportEmail,
+			},
+
+			"login_help": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"footer": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"error": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"new_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminCustomizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read customization settings")
+	settings, err := tfeClient.Admin.Settings.Customization.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading customization settings: %v", err)
+	}
+
+	// Update the config.
+	d.Set("support_email_address", settings.SupportEmail)
+	d.Set("login_help", settings.LoginHelp)
+	d.Set("footer", settings.Footer)
+	d.Set("error", settings.Error)
+	d.Set("new_user", settings.NewUser)
+
+	return nil
+}
+
+func resourceTFEAdminCustomizationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminCustomizationSettingsUpdateOptions{
+		SupportEmail: tfe.String(d.Get("support_email_address").(string)),
+		LoginHelp:    tfe.String(d.Get("login_help").(string)),
+		Footer:       tfe.String(d.Get("footer").(string)),
+		Error:        tfe.String(d.Get("error").(string)),
+		NewUser:      tfe.String(d.Get("new_user").(string)),
+	}
+
+	log.Printf("[DEBUG] Update customization settings")
+	settings, err := tfeClient.Admin.Settings.Customization.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating customization settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFEAdminCustomizationSettingsRead(d, meta)
+}
+
+func resourceTFEAdminCustomizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset customization settings to their defaults")
+	_, err := tfeClient.Admin.Settings.Customization.Update(ctx, adminCustomizationSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting customization settings: %v", err)
+	}
+
+	return nil
+}
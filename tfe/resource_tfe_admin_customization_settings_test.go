@@ -0,0 +1,61 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminCustomizationSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminCustomizationSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminCustomizationSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_customization_settings.foobar", "support_email_address", "tfe-support@example.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_customization_settings.foobar", "login_help", "Sign in with your company account."),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_customization_settings.foobar", "footer", "Managed by the platform team."),
+				),
+			},
+
+			{
+				ResourceName:      "tfe_admin_customization_settings.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminCustomizationSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	settings, err := tfeClient.Admin.Settings.Customization.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	if settings.Footer != "" {
+		return fmt.Errorf("Customization settings were not reset, footer is %s", settings.Footer)
+	}
+
+	return nil
+}
+
+const testAccTFEAdminCustomizationSettings_basic = `
+resource "tfe_admin_customization_settings" "foobar" {
+  support_email_address = "tfe-support@example.com"
+  login_help            = "Sign in with your company account."
+  footer                = "Managed by the platform team."
+}`
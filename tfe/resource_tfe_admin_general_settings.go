@@ -0,0 +1,126 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adminGeneralSettingsDefaults are the general settings of a fresh Terraform
+// Enterprise installation. They are restored when the resource is destroyed.
+var adminGeneralSettingsDefaults = tfe.AdminGeneralSettingsUpdateOptions{
+	LimitUserOrgCreation:              tfe.Bool(true),
+	APIRateLimitingEnabled:            tfe.Bool(true),
+	APIRateLimit:                      tfe.Int(30),
+	SendPassingStatusUntriggeredPlans: tfe.Bool(false),
+	AllowSpeculativePlansOnPR:         tfe.Bool(false),
+	DefaultRemoteStateAccess:          tfe.Bool(true),
+}
+
+func resourceTFEAdminGeneralSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminGeneralSettingsUpdate,
+		Read:   resourceTFEAdminGeneralSettingsRead,
+		Update: resourceTFEAdminGeneralSettingsUpdate,
+		Delete: resourceTFEAdminGeneralSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"limit_user_organization_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.LimitUserOrgCreation,
+			},
+
+			"api_rate_limiting_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.APIRateLimitingEnabled,
+			},
+
+			"api_rate_limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.APIRateLimit,
+			},
+
+			"send_passing_statuses_for_untriggered_speculative_plans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.SendPassingStatusUntriggeredPlans,
+			},
+
+			"allow_speculative_plans_on_pull_requests_from_forks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.AllowSpeculativePlansOnPR,
+			},
+
+			"default_remote_state_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminGeneralSettingsDefaults.DefaultRemoteStateAccess,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminGeneralSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read general settings")
+	settings, err := tfeClient.Admin.Settings.General.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading general settings: %v", err)
+	}
+
+	// Update the config.
+	d.Set("limit_user_organization_creation", settings.LimitUserOrganizationCreation)
+	d.Set("api_rate_limiting_enabled", settings.APIRateLimitingEnabled)
+	d.Set("api_rate_limit", settings.APIRateLimit)
+	d.Set("send_passing_statuses_for_untriggered_speculative_plans", settings.SendPassingStatusesEnabled)
+	d.Set("allow_speculative_plans_on_pull_requests_from_forks", settings.AllowSpeculativePlansOnPR)
+	d.Set("default_remote_state_access", settings.DefaultRemoteStateAccess)
+
+	return nil
+}
+
+func resourceTFEAdminGeneralSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminGeneralSettingsUpdateOptions{
+		LimitUserOrgCreation:              tfe.Bool(d.Get("limit_user_organization_creation").(bool)),
+		APIRateLimitingEnabled:            tfe.Bool(d.Get("api_rate_limiting_enabled").(bool)),
+		APIRateLimit:                      tfe.Int(d.Get("api_rate_limit").(int)),
+		SendPassingStatusUntriggeredPlans: tfe.Bool(d.Get("send_passing_statuses_for_untriggered_speculative_plans").(bool)),
+		AllowSpeculativePlansOnPR:         tfe.Bool(d.Get("allow_speculative_plans_on_pull_requests_from_forks").(bool)),
+		DefaultRemoteStateAccess:          tfe.Bool(d.Get("default_remote_state_access").(bool)),
+	}
+
+	log.Printf("[DEBUG] Update general settings")
+	settings, err := tfeClient.Admin.Settings.General.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating general settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFEAdminGeneralSettingsRead(d, meta)
+}
+
+func resourceTFEAdminGeneralSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset general settings to their defaults")
+	_, err := tfeClient.Admin.Settings.General.Update(ctx, adminGeneralSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting general settings: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminGeneralSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminGeneralSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminGeneralSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "limit_user_organization_creation", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "api_rate_limit", "30"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "default_remote_state_access", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFEAdminGeneralSettings_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "limit_user_organization_creation", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "api_rate_limit", "60"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "send_passing_statuses_for_untriggered_speculative_plans", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "allow_speculative_plans_on_pull_requests_from_forks", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_general_settings.foobar", "default_remote_state_access", "false"),
+				),
+			},
+
+			{
+				ResourceName:      "tfe_admin_general_settings.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminGeneralSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	settings, err := tfeClient.Admin.Settings.General.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	if settings.APIRateLimit != *adminGeneralSettingsDefaults.APIRateLimit {
+		return fmt.Errorf("General settings were not reset, API rate limit is %d", settings.APIRateLimit)
+	}
+
+	if settings.DefaultRemoteStateAccess != *adminGeneralSettingsDefaults.DefaultRemoteStateAccess {
+		return fmt.Errorf("General settings were not reset, default remote state access is %t", settings.DefaultRemoteStateAccess)
+	}
+
+	return nil
+}
+
+const testAccTFEAdminGeneralSettings_basic = `
+resource "tfe_admin_general_settings" "foobar" {}`
+
+const testAccTFEAdminGeneralSettings_update = `
+resource "tfe_admin_general_settings" "foobar" {
+  limit_user_organization_creation                        = false
+  api_rate_limit                                          = 60
+  send_passing_statuses_for_untriggered_speculative_plans = true
+  allow_speculative_plans_on_pull_requests_from_forks     = true
+  default_remote_state_access                             = false
+}`
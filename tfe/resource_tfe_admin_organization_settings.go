@@ -0,0 +1,214 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEAdminOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminOrganizationSettingsCreate,
+		Read:   resourceTFEAdminOrganizationSettingsRead,
+		Update: resourceTFEAdminOrganizationSettingsUpdate,
+		Delete: resourceTFEAdminOrganizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"access_beta_tools": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"workspace_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"global_module_sharing": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"module_sharing_consumer_organizations"},
+			},
+
+			"module_sharing_consumer_organizations": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"global_module_sharing"},
+			},
+
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sso_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminOrganizationSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	// The organization already exists, so creating the resource only takes
+	// over the management of its admin settings.
+	organization := d.Get("organization").(string)
+
+	log.Printf("[DEBUG] Create admin settings for organization: %s", organization)
+	d.SetId(organization)
+
+	return resourceTFEAdminOrganizationSettingsUpdate(d, meta)
+}
+
+func resourceTFEAdminOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read admin settings of organization: %s", d.Id())
+	org, err := tfeClient.Admin.Organizations.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Organization %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading admin settings of organization %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("organization", org.Name)
+	d.Set("access_beta_tools", org.AccessBetaTools)
+	d.Set("is_disabled", org.IsDisabled)
+	d.Set("sso_enabled", org.SsoEnabled)
+
+	globalModuleSharing := false
+	if org.GlobalModuleSharing != nil {
+		globalModuleSharing = *org.GlobalModuleSharing
+	}
+	d.Set("global_module_sharing", globalModuleSharing)
+
+	workspaceLimit := 0
+	if org.WorkspaceLimit != nil {
+		workspaceLimit = *org.WorkspaceLimit
+	}
+	d.Set("workspace_limit", workspaceLimit)
+
+	consumers, err := listAdminOrganizationModuleConsumers(tfeClient, d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("module_sharing_consumer_organizations", consumers)
+
+	return nil
+}
+
+func resourceTFEAdminOrganizationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminOrganizationUpdateOptions{
+		AccessBetaTools:     tfe.Bool(d.Get("access_beta_tools").(bool)),
+		GlobalModuleSharing: tfe.Bool(d.Get("global_module_sharing").(bool)),
+		IsDisabled:          tfe.Bool(d.Get("is_disabled").(bool)),
+	}
+
+	// The API does not allow a workspace limit to be removed, so it is only
+	// sent when configured.
+	if v, ok := d.GetOk("workspace_limit"); ok {
+		options.WorkspaceLimit = tfe.Int(v.(int))
+	}
+
+	log.Printf("[DEBUG] Update admin settings of organization: %s", d.Id())
+	_, err := tfeClient.Admin.Organizations.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating admin settings of organization %s: %v", d.Id(), err)
+	}
+
+	if d.HasChange("module_sharing_consumer_organizations") {
+		var consumers []string
+		for _, name := range d.Get("module_sharing_consumer_organizations").(*schema.Set).List() {
+			consumers = append(consumers, name.(string))
+		}
+
+		log.Printf("[DEBUG] Update %s module consumers", d.Id())
+		err := tfeClient.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), consumers)
+		if err != nil {
+			return fmt.Errorf("Error updating module consumers of organization %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFEAdminOrganizationSettingsRead(d, meta)
+}
+
+func resourceTFEAdminOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct with the default settings.
+	options := tfe.AdminOrganizationUpdateOptions{
+		AccessBetaTools:     tfe.Bool(false),
+		GlobalModuleSharing: tfe.Bool(false),
+		IsDisabled:          tfe.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Reset admin settings of organization: %s", d.Id())
+	_, err := tfeClient.Admin.Organizations.Update(ctx, d.Id(), options)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error resetting admin settings of organization %s: %v", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Disable module sharing for organization: %s", d.Id())
+	err = tfeClient.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), []string{})
+	if err != nil {
+		return fmt.Errorf("Error resetting module consumers of organization %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// listAdminOrganizationModuleConsumers returns the names of all organizations
+// the given organization shares its private modules with.
+func listAdminOrganizationModuleConsumers(client *tfe.Client, organization string) ([]interface{}, error) {
+	var consumers []interface{}
+
+	options := &tfe.AdminOrganizationListModuleConsumersOptions{}
+	for {
+		l, err := client.Admin.Organizations.ListModuleConsumers(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving module consumers of organization %s: %v", organization, err)
+		}
+
+		for _, org := range l.Items {
+			consumers = append(consumers, org.Name)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return consumers, nil
+}
@@ -0,0 +1,125 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminOrganizationSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminOrganizationSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminOrganizationSettings_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "access_beta_tools", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "workspace_limit", "15"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "global_module_sharing", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "module_sharing_consumer_organizations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_admin_organization_settings.settings", "module_sharing_consumer_organizations.*",
+						"tfe_organization.consumer", "name"),
+				),
+			},
+
+			{
+				Config: testAccTFEAdminOrganizationSettings_globalModuleSharing(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "access_beta_tools", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "global_module_sharing", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.settings", "module_sharing_consumer_organizations.#", "0"),
+				),
+			},
+
+			{
+				ResourceName:      "tfe_admin_organization_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminOrganizationSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_admin_organization_settings" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		org, err := tfeClient.Admin.Organizations.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			// The organization itself was destroyed as well.
+			continue
+		}
+
+		if org.AccessBetaTools || (org.GlobalModuleSharing != nil && *org.GlobalModuleSharing) {
+			return fmt.Errorf("Admin settings of organization %s were not reset", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEAdminOrganizationSettings_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization" "consumer" {
+  name  = "tst-terraform-consumer-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_admin_organization_settings" "settings" {
+  organization                          = tfe_organization.foobar.name
+  access_beta_tools                     = true
+  workspace_limit                       = 15
+  module_sharing_consumer_organizations = [tfe_organization.consumer.name]
+}`, rInt, rInt)
+}
+
+func testAccTFEAdminOrganizationSettings_globalModuleSharing(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization" "consumer" {
+  name  = "tst-terraform-consumer-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_admin_organization_settings" "settings" {
+  organization          = tfe_organization.foobar.name
+  workspace_limit       = 15
+  global_module_sharing = true
+}`, rInt, rInt)
+}
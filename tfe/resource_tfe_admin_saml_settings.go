@@ -0,0 +1,259 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// adminSAMLSettingsDefaults are the SAML settings of a fresh Terraform
+// Enterprise installation. They are restored when the resource is destroyed.
+var adminSAMLSettingsDefaults = tfe.AdminSAMLSettingsUpdateOptions{
+	Enabled:                   tfe.Bool(false),
+	Debug:                     tfe.Bool(false),
+	TeamManagementEnabled:     tfe.Bool(false),
+	AuthnRequestsSigned:       tfe.Bool(false),
+	WantAssertionsSigned:      tfe.Bool(false),
+	AttrUsername:              tfe.String("Username"),
+	AttrGroups:                tfe.String("MemberOf"),
+	AttrSiteAdmin:             tfe.String("SiteAdmin"),
+	SiteAdminRole:             tfe.String("site-admins"),
+	SSOAPITokenSessionTimeout: tfe.Int(1209600),
+	SignatureSigningMethod:    tfe.String("SHA256"),
+	SignatureDigestMethod:     tfe.String("SHA256"),
+}
+
+func resourceTFEAdminSAMLSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminSAMLSettingsUpdate,
+		Read:   resourceTFEAdminSAMLSettingsRead,
+		Update: resourceTFEAdminSAMLSettingsUpdate,
+		Delete: resourceTFEAdminSAMLSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.Enabled,
+			},
+
+			"debug": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.Debug,
+			},
+
+			"team_management_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.TeamManagementEnabled,
+			},
+
+			"authn_requests_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.AuthnRequestsSigned,
+			},
+
+			"want_assertions_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.WantAssertionsSigned,
+			},
+
+			"idp_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"slo_endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"sso_endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"attr_username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.AttrUsername,
+			},
+
+			"attr_groups": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.AttrGroups,
+			},
+
+			"attr_site_admin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.AttrSiteAdmin,
+			},
+
+			"site_admin_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.SiteAdminRole,
+			},
+
+			"sso_api_token_session_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  *adminSAMLSettingsDefaults.SSOAPITokenSessionTimeout,
+			},
+
+			"signature_signing_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      *adminSAMLSettingsDefaults.SignatureSigningMethod,
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256"}, false),
+			},
+
+			"signature_digest_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      *adminSAMLSettingsDefaults.SignatureDigestMethod,
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256"}, false),
+			},
+
+			"old_idp_cert": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"acs_consumer_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminSAMLSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read SAML settings")
+	settings, err := tfeClient.Admin.Settings.SAML.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading SAML settings: %v", err)
+	}
+
+	// Update the config. The private key is never returned by the API, so
+	// the configured value is kept.
+	d.Set("enabled", settings.Enabled)
+	d.Set("debug", settings.Debug)
+	d.Set("team_management_enabled", settings.TeamManagementEnabled)
+	d.Set("authn_requests_signed", settings.AuthnRequestsSigned)
+	d.Set("want_assertions_signed", settings.WantAssertionsSigned)
+	d.Set("idp_cert", settings.IDPCert)
+	d.Set("certificate", settings.Certificate)
+	d.Set("slo_endpoint_url", settings.SLOEndpointURL)
+	d.Set("sso_endpoint_url", settings.SSOEndpointURL)
+	d.Set("attr_username", settings.AttrUsername)
+	d.Set("attr_groups", settings.AttrGroups)
+	d.Set("attr_site_admin", settings.AttrSiteAdmin)
+	d.Set("site_admin_role", settings.SiteAdminRole)
+	d.Set("sso_api_token_session_timeout", settings.SSOAPITokenSessionTimeout)
+	d.Set("signature_signing_method", settings.SignatureSigningMethod)
+	d.Set("signature_digest_method", settings.SignatureDigestMethod)
+	d.Set("old_idp_cert", settings.OldIDPCert)
+	d.Set("acs_consumer_url", settings.ACSConsumerURL)
+	d.Set("metadata_url", settings.MetadataURL)
+
+	return nil
+}
+
+func resourceTFEAdminSAMLSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminSAMLSettingsUpdateOptions{
+		Enabled:                   tfe.Bool(d.Get("enabled").(bool)),
+		Debug:                     tfe.Bool(d.Get("debug").(bool)),
+		TeamManagementEnabled:     tfe.Bool(d.Get("team_management_enabled").(bool)),
+		AuthnRequestsSigned:       tfe.Bool(d.Get("authn_requests_signed").(bool)),
+		WantAssertionsSigned:      tfe.Bool(d.Get("want_assertions_signed").(bool)),
+		AttrUsername:              tfe.String(d.Get("attr_username").(string)),
+		AttrGroups:                tfe.String(d.Get("attr_groups").(string)),
+		AttrSiteAdmin:             tfe.String(d.Get("attr_site_admin").(string)),
+		SiteAdminRole:             tfe.String(d.Get("site_admin_role").(string)),
+		SSOAPITokenSessionTimeout: tfe.Int(d.Get("sso_api_token_session_timeout").(int)),
+		SignatureSigningMethod:    tfe.String(d.Get("signature_signing_method").(string)),
+		SignatureDigestMethod:     tfe.String(d.Get("signature_digest_method").(string)),
+	}
+
+	// Only send the certificates, keys and endpoints when they changed. The
+	// certificates and endpoints are computed, so leaving them out of the
+	// configuration keeps the values that are already set on the server.
+	if d.HasChange("idp_cert") {
+		options.IDPCert = tfe.String(d.Get("idp_cert").(string))
+	}
+
+	if d.HasChange("certificate") {
+		options.Certificate = tfe.String(d.Get("certificate").(string))
+	}
+
+	if d.HasChange("private_key") {
+		options.PrivateKey = tfe.String(d.Get("private_key").(string))
+	}
+
+	if d.HasChange("slo_endpoint_url") {
+		options.SLOEndpointURL = tfe.String(d.Get("slo_endpoint_url").(string))
+	}
+
+	if d.HasChange("sso_endpoint_url") {
+		options.SSOEndpointURL = tfe.String(d.Get("sso_endpoint_url").(string))
+	}
+
+	log.Printf("[DEBUG] Update SAML settings")
+	settings, err := tfeClient.Admin.Settings.SAML.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating SAML settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFEAdminSAMLSettingsRead(d, meta)
+}
+
+func resourceTFEAdminSAMLSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset SAML settings to their defaults")
+	_, err := tfeClient.Admin.Settings.SAML.Update(ctx, adminSAMLSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting SAML settings: %v", err)
+	}
+
+	return nil
+}
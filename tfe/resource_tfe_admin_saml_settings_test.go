@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminSAMLSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminSAMLSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminSAMLSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_saml_settings.foobar", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_saml_settings.foobar", "attr_username", "tst-username"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_saml_settings.foobar", "attr_groups", "tst-groups"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_saml_settings.foobar", "site_admin_role", "tst-admins"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_saml_settings.foobar", "sso_api_token_session_timeout", "3600"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_admin_saml_settings.foobar", "acs_consumer_url"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_admin_saml_settings.foobar", "metadata_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminSAMLSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	settings, err := tfeClient.Admin.Settings.SAML.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	if settings.AttrUsername != *adminSAMLSettingsDefaults.AttrUsername {
+		return fmt.Errorf("SAML settings were not reset, username attribute is %s", settings.AttrUsername)
+	}
+
+	if settings.SiteAdminRole != *adminSAMLSettingsDefaults.SiteAdminRole {
+		return fmt.Errorf("SAML settings were not reset, site admin role is %s", settings.SiteAdminRole)
+	}
+
+	return nil
+}
+
+const testAccTFEAdminSAMLSettings_basic = `
+resource "tfe_admin_saml_settings" "foobar" {
+  attr_username                 = "tst-username"
+  attr_groups                   = "tst-groups"
+  site_admin_role               = "tst-admins"
+  sso_api_token_session_timeout = 3600
+}`
@@ -0,0 +1,157 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// adminSMTPSettingsDefaults are the SMTP settings of a fresh Terraform
+// Enterprise installation. They are restored when the resource is destroyed.
+var adminSMTPSettingsDefaults = tfe.AdminSMTPSettingsUpdateOptions{
+	Enabled:  tfe.Bool(false),
+	Host:     tfe.String(""),
+	Port:     tfe.Int(25),
+	Sender:   tfe.String(""),
+	Auth:     tfe.SMTPAuthValue(tfe.SMTPAuthNone),
+	Username: tfe.String(""),
+	Password: tfe.String(""),
+}
+
+func resourceTFEAdminSMTPSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminSMTPSettingsUpdate,
+		Read:   resourceTFEAdminSMTPSettingsRead,
+		Update: resourceTFEAdminSMTPSettingsUpdate,
+		Delete: resourceTFEAdminSMTPSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminSMTPSettingsDefaults.Enabled,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  *adminSMTPSettingsDefaults.Port,
+			},
+
+			"sender": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"auth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(*adminSMTPSettingsDefaults.Auth),
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.SMTPAuthNone),
+						string(tfe.SMTPAuthPlain),
+						string(tfe.SMTPAuthLogin),
+					},
+					false,
+				),
+			},
+
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"test_email_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminSMTPSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read SMTP settings")
+	settings, err := tfeClient.Admin.Settings.SMTP.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading SMTP settings: %v", err)
+	}
+
+	// Update the config. The password and test email address are never
+	// returned by the API, so the configured values are kept.
+	d.Set("enabled", settings.Enabled)
+	d.Set("host", settings.Host)
+	d.Set("port", settings.Port)
+	d.Set("sender", settings.Sender)
+	d.Set("auth", string(settings.Auth))
+	d.Set("username", settings.Username)
+
+	return nil
+}
+
+func resourceTFEAdminSMTPSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminSMTPSettingsUpdateOptions{
+		Enabled:  tfe.Bool(d.Get("enabled").(bool)),
+		Host:     tfe.String(d.Get("host").(string)),
+		Port:     tfe.Int(d.Get("port").(int)),
+		Sender:   tfe.String(d.Get("sender").(string)),
+		Auth:     tfe.SMTPAuthValue(tfe.SMTPAuthType(d.Get("auth").(string))),
+		Username: tfe.String(d.Get("username").(string)),
+	}
+
+	if d.HasChange("password") {
+		options.Password = tfe.String(d.Get("password").(string))
+	}
+
+	// The API sends a test email to this address to verify the settings, so
+	// it is only sent when the address changed to avoid an email per update.
+	if d.HasChange("test_email_address") {
+		if v, ok := d.GetOk("test_email_address"); ok {
+			options.TestEmailAddress = tfe.String(v.(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Update SMTP settings")
+	settings, err := tfeClient.Admin.Settings.SMTP.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating SMTP settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFEAdminSMTPSettingsRead(d, meta)
+}
+
+func resourceTFEAdminSMTPSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset SMTP settings to their defaults")
+	_, err := tfeClient.Admin.Settings.SMTP.Update(ctx, adminSMTPSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting SMTP settings: %v", err)
+	}
+
+	return nil
+}
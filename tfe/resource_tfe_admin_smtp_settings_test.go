@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminSMTPSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminSMTPSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminSMTPSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "port", "587"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "sender", "tfe@example.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "auth", "login"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_smtp_settings.foobar", "username", "tfe"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminSMTPSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	settings, err := tfeClient.Admin.Settings.SMTP.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	if settings.Enabled {
+		return fmt.Errorf("SMTP settings were not reset, SMTP is still enabled")
+	}
+
+	if settings.Auth != *adminSMTPSettingsDefaults.Auth {
+		return fmt.Errorf("SMTP settings were not reset, auth is %s", settings.Auth)
+	}
+
+	return nil
+}
+
+const testAccTFEAdminSMTPSettings_basic = `
+resource "tfe_admin_smtp_settings" "foobar" {
+  host     = "smtp.example.com"
+  port     = 587
+  sender   = "tfe@example.com"
+  auth     = "login"
+  username = "tfe"
+  password = "secret"
+}`
@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adminTwilioSettingsDefaults are the Twilio settings of a fresh Terraform
+// Enterprise installation. They are restored when the resource is destroyed.
+var adminTwilioSettingsDefaults = tfe.AdminTwilioSettingsUpdateOptions{
+	Enabled:    tfe.Bool(false),
+	AccountSid: tfe.String(""),
+	AuthToken:  tfe.String(""),
+	FromNumber: tfe.String(""),
+}
+
+func resourceTFEAdminTwilioSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminTwilioSettingsUpdate,
+		Read:   resourceTFEAdminTwilioSettingsRead,
+		Update: resourceTFEAdminTwilioSettingsUpdate,
+		Delete: resourceTFEAdminTwilioSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  *adminTwilioSettingsDefaults.Enabled,
+			},
+
+			"account_sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"auth_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"from_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"test_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminTwilioSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read Twilio settings")
+	settings, err := tfeClient.Admin.Settings.Twilio.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading Twilio settings: %v", err)
+	}
+
+	// Update the config. The auth token and test number are never returned
+	// by the API, so the configured values are kept.
+	d.Set("enabled", settings.Enabled)
+	d.Set("account_sid", settings.AccountSid)
+	d.Set("from_number", settings.FromNumber)
+
+	return nil
+}
+
+func resourceTFEAdminTwilioSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.AdminTwilioSettingsUpdateOptions{
+		Enabled:    tfe.Bool(d.Get("enabled").(bool)),
+		AccountSid: tfe.String(d.Get("account_sid").(string)),
+		FromNumber: tfe.String(d.Get("from_number").(string)),
+	}
+
+	if d.HasChange("auth_token") {
+		options.AuthToken = tfe.String(d.Get("auth_token").(string))
+	}
+
+	log.Printf("[DEBUG] Update Twilio settings")
+	settings, err := tfeClient.Admin.Settings.Twilio.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating Twilio settings: %v", err)
+	}
+
+	d.SetId(settings.ID)
+
+	// The API sends a test SMS to this number to verify the settings, so it
+	// is only sent when the number changed to avoid an SMS per update.
+	if d.HasChange("test_number") {
+		if v, ok := d.GetOk("test_number"); ok {
+			log.Printf("[DEBUG] Verify Twilio settings")
+			err := tfeClient.Admin.Settings.Twilio.Verify(ctx, tfe.AdminTwilioSettingsVerifyOptions{
+				TestNumber: tfe.String(v.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error verifying Twilio settings: %v", err)
+			}
+		}
+	}
+
+	return resourceTFEAdminTwilioSettingsRead(d, meta)
+}
+
+func resourceTFEAdminTwilioSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Reset Twilio settings to their defaults")
+	_, err := tfeClient.Admin.Settings.Twilio.Update(ctx, adminTwilioSettingsDefaults)
+	if err != nil {
+		return fmt.Errorf("Error resetting Twilio settings: %v", err)
+	}
+
+	return nil
+}
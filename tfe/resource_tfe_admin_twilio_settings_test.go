@@ -0,0 +1,59 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAdminTwilioSettings_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAdminTwilioSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminTwilioSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_twilio_settings.foobar", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_twilio_settings.foobar", "account_sid", "AC0123456789"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_twilio_settings.foobar", "from_number", "+15555550100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAdminTwilioSettingsDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	settings, err := tfeClient.Admin.Settings.Twilio.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	if settings.Enabled {
+		return fmt.Errorf("Twilio settings were not reset, Twilio is still enabled")
+	}
+
+	if settings.AccountSid != *adminTwilioSettingsDefaults.AccountSid {
+		return fmt.Errorf("Twilio settings were not reset, account SID is %s", settings.AccountSid)
+	}
+
+	return nil
+}
+
+const testAccTFEAdminTwilioSettings_basic = `
+resource "tfe_admin_twilio_settings" "foobar" {
+  account_sid = "AC0123456789"
+  auth_token  = "secret"
+  from_number = "+15555550100"
+}`
@@ -0,0 +1,195 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFEAdminUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminUserCreate,
+		Read:   resourceTFEAdminUserRead,
+		Update: resourceTFEAdminUserUpdate,
+		Delete: resourceTFEAdminUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFEAdminUserImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"site_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"suspended": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"two_factor_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_service_account": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAdminUserCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// The user already exists, so creating the resource only takes over the
+	// management of its site admin and suspension status.
+	username := d.Get("username").(string)
+	user, err := fetchAdminUserByUsername(tfeClient, username)
+	if err != nil {
+		return fmt.Errorf("Error retrieving user %s: %v", username, err)
+	}
+
+	d.SetId(user.ID)
+
+	return resourceTFEAdminUserUpdate(d, meta)
+}
+
+func resourceTFEAdminUserRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read user: %s", d.Id())
+	user, err := fetchAdminUserByUsername(tfeClient, d.Get("username").(string))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] User %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading user %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("username", user.Username)
+	d.Set("site_admin", user.IsAdmin)
+	d.Set("suspended", user.IsSuspended)
+	d.Set("email", user.Email)
+	d.Set("is_service_account", user.IsServiceAccount)
+	d.Set("two_factor_enabled", user.TwoFactor != nil && user.TwoFactor.Enabled)
+
+	return nil
+}
+
+func resourceTFEAdminUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Use the current status of the user to determine which changes are
+	// needed, as the user might not have been managed by Terraform before.
+	user, err := fetchAdminUserByUsername(tfeClient, d.Get("username").(string))
+	if err != nil {
+		return fmt.Errorf("Error retrieving user %s: %v", d.Id(), err)
+	}
+
+	siteAdmin := d.Get("site_admin").(bool)
+	if siteAdmin != user.IsAdmin {
+		if siteAdmin {
+			log.Printf("[DEBUG] Grant site admin privileges to user: %s", d.Id())
+			_, err = tfeClient.Admin.Users.GrantAdmin(ctx, d.Id())
+		} else {
+			log.Printf("[DEBUG] Revoke site admin privileges from user: %s", d.Id())
+			_, err = tfeClient.Admin.Users.RevokeAdmin(ctx, d.Id())
+		}
+		if err != nil {
+			return fmt.Errorf("Error updating site admin privileges of user %s: %v", d.Id(), err)
+		}
+	}
+
+	suspended := d.Get("suspended").(bool)
+	if suspended != user.IsSuspended {
+		if suspended {
+			log.Printf("[DEBUG] Suspend user: %s", d.Id())
+			_, err = tfeClient.Admin.Users.Suspend(ctx, d.Id())
+		} else {
+			log.Printf("[DEBUG] Unsuspend user: %s", d.Id())
+			_, err = tfeClient.Admin.Users.Unsuspend(ctx, d.Id())
+		}
+		if err != nil {
+			return fmt.Errorf("Error updating suspension of user %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFEAdminUserRead(d, meta)
+}
+
+func resourceTFEAdminUserDelete(d *schema.ResourceData, meta interface{}) error {
+	// Deleting a user is irreversible, and restoring its privileges or
+	// unsuspending it is rarely what is intended, so the user is left as is
+	// and only removed from the state.
+	log.Printf("[DEBUG] Stop managing user: %s", d.Id())
+
+	return nil
+}
+
+func resourceTFEAdminUserImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(*tfe.Client)
+
+	user, err := fetchAdminUserByUsername(tfeClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user %s: %v", d.Id(), err)
+	}
+
+	// Set the fields that are part of the import ID.
+	d.Set("username", user.Username)
+	d.SetId(user.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// fetchAdminUserByUsername returns the user with the exact given username,
+// or tfe.ErrResourceNotFound if no such user exists.
+func fetchAdminUserByUsername(client *tfe.Client, username string) (*tfe.AdminUser, error) {
+	// The query also matches partial usernames and emails, so the results
+	// still need to be compared against the exact username.
+	options := &tfe.AdminUserListOptions{
+		Query: username,
+	}
+
+	for {
+		l, err := client.Admin.Users.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing users: %v", err)
+		}
+
+		for _, user := range l.Items {
+			if user.Username == username {
+				return user, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, tfe.ErrResourceNotFound
+}
@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEAdminUser_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if TFE_USER1 == "" {
+				t.Skip("Please set TFE_USER1 to run this test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAdminUser_basic(TFE_USER1, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "username", TFE_USER1),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "site_admin", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "suspended", "false"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_admin_user.foobar", "email"),
+				),
+			},
+
+			{
+				Config: testAccTFEAdminUser_basic(TFE_USER1, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "site_admin", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "suspended", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFEAdminUser_basic(TFE_USER1, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_admin_user.foobar", "suspended", "false"),
+				),
+			},
+
+			{
+				ResourceName:      "tfe_admin_user.foobar",
+				ImportState:       true,
+				ImportStateId:     TFE_USER1,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTFEAdminUser_basic(username string, siteAdmin, suspended bool) string {
+	return fmt.Sprintf(`
+resource "tfe_admin_user" "foobar" {
+  username   = "%s"
+  site_admin = %t
+  suspended  = %t
+}`, username, siteAdmin, suspended)
+}
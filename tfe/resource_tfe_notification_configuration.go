@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"log"
+	"strconv"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +35,7 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 						string(tfe.NotificationDestinationTypeEmail),
 						string(tfe.NotificationDestinationTypeGeneric),
 						string(tfe.NotificationDestinationTypeSlack),
+						string(tfe.NotificationDestinationTypeMicrosoftTeams),
 					},
 					false,
 				),
@@ -80,6 +82,9 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 							string(tfe.NotificationTriggerApplying),
 							string(tfe.NotificationTriggerCompleted),
 							string(tfe.NotificationTriggerErrored),
+							string(tfe.NotificationTriggerAssessmentDrifted),
+							string(tfe.NotificationTriggerAssessmentFailed),
+							string(tfe.NotificationTriggerAssessmentCheckFailed),
 						},
 						false,
 					),
@@ -92,6 +97,12 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 				ConflictsWith: []string{"email_addresses", "email_user_ids"},
 			},
 
+			"verify_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"workspace_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -138,6 +149,14 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		if err != nil {
 			return err
 		}
+	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
+		// When destination_type is 'microsoft-teams':
+		// 1. email_addresses, email_user_ids, and token cannot be set
+		// 2. url must be set
+		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create a new options struct
@@ -176,6 +195,14 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 
 	d.SetId(notificationConfiguration.ID)
 
+	if d.Get("verify_on_create").(bool) {
+		log.Printf("[DEBUG] Verify notification configuration: %s", notificationConfiguration.ID)
+		err := verifyNotificationConfiguration(tfeClient, notificationConfiguration.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceTFENotificationConfigurationRead(d, meta)
 }
 
@@ -259,6 +286,14 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return err
 		}
+	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
+		// When destination_type is 'microsoft-teams':
+		// 1. email_addresses, email_user_ids, and token cannot be set
+		// 2. url must be set
+		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create a new options struct
@@ -312,6 +347,29 @@ func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+// verifyNotificationConfiguration sends a test notification to the
+// destination and returns an error unless it responded with a 2xx status.
+func verifyNotificationConfiguration(tfeClient *tfe.Client, id string) error {
+	notificationConfiguration, err := tfeClient.NotificationConfigurations.Verify(ctx, id)
+	if err != nil {
+		return fmt.Errorf("Error verifying notification configuration %s: %v", id, err)
+	}
+
+	if len(notificationConfiguration.DeliveryResponses) == 0 {
+		return fmt.Errorf("Error verifying notification configuration %s: no delivery response received", id)
+	}
+
+	response := notificationConfiguration.DeliveryResponses[len(notificationConfiguration.DeliveryResponses)-1]
+	code, err := strconv.Atoi(response.Code)
+	if err != nil || code < 200 || code > 299 {
+		return fmt.Errorf(
+			"Error verifying notification configuration %s: %s did not respond with a 2xx status code (got %q)",
+			id, response.URL, response.Code)
+	}
+
+	return nil
+}
+
 // Custom CustomizeDiff functions and helpers
 func validateSchemaAttributesForDestinationTypeEmail(d *schema.ResourceData) error {
 	// Make sure url and token are not set when destination_type is 'email'
@@ -323,6 +381,9 @@ func validateSchemaAttributesForDestinationTypeEmail(d *schema.ResourceData) err
 	if tokenIsSet && token != "" {
 		return fmt.Errorf("Token cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeEmail))
 	}
+	if d.Get("verify_on_create").(bool) {
+		return fmt.Errorf("Verify on create cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeEmail))
+	}
 
 	return nil
 }
@@ -370,3 +431,27 @@ func validateSchemaAttributesForDestinationTypeSlack(d *schema.ResourceData) err
 
 	return nil
 }
+
+func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.ResourceData) error {
+	// Make sure email_addresses, email_user_ids, and token are not set when destination_type is 'microsoft-teams'
+	_, emailAddressesIsSet := d.GetOk("email_addresses")
+	if emailAddressesIsSet {
+		return fmt.Errorf("Email addresses cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
+	}
+	_, emailUserIDsIsSet := d.GetOk("email_user_ids")
+	if emailUserIDsIsSet {
+		return fmt.Errorf("Email user IDs cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
+	}
+	token, tokenIsSet := d.GetOk("token")
+	if tokenIsSet && token != "" {
+		return fmt.Errorf("Token cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
+	}
+
+	// Make sure url is set when destination_type is 'microsoft-teams'
+	_, urlIsSet := d.GetOk("url")
+	if !urlIsSet {
+		return fmt.Errorf("URL is required with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
+	}
+
+	return nil
+}
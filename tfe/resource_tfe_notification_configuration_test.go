@@ -377,6 +377,108 @@ func TestAccTFENotificationConfiguration_updateValidateSchemaAttributesSlack(t *
 	})
 }
 
+func TestAccTFENotificationConfiguration_microsoftTeams(t *testing.T) {
+	notificationConfiguration := &tfe.NotificationConfiguration{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_microsoftTeams(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", notificationConfiguration),
+					testAccCheckTFENotificationConfigurationAttributesMicrosoftTeams(notificationConfiguration),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "destination_type", "microsoft-teams"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "name", "notification_msteams"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "url", "http://example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFENotificationConfiguration_assessmentTriggers(t *testing.T) {
+	notificationConfiguration := &tfe.NotificationConfiguration{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_assessmentTriggers(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", notificationConfiguration),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "name", "notification_assessment_triggers"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "3"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_notification_configuration.foobar", "triggers.*", "assessment:drifted"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_notification_configuration.foobar", "triggers.*", "assessment:failed"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_notification_configuration.foobar", "triggers.*", "assessment:check_failure"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFENotificationConfiguration_validateSchemaAttributesMicrosoftTeams(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFENotificationConfiguration_microsoftTeamsWithEmailAddresses(rInt),
+				ExpectError: regexp.MustCompile(`Email addresses cannot be set with destination type of microsoft-teams`),
+			},
+			{
+				Config:      testAccTFENotificationConfiguration_microsoftTeamsWithToken(rInt),
+				ExpectError: regexp.MustCompile(`Token cannot be set with destination type of microsoft-teams`),
+			},
+			{
+				Config:      testAccTFENotificationConfiguration_microsoftTeamsWithoutURL(rInt),
+				ExpectError: regexp.MustCompile(`URL is required with destination type of microsoft-teams`),
+			},
+		},
+	})
+}
+
+func TestAccTFENotificationConfiguration_verifyOnCreate(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFENotificationConfiguration_emailWithVerifyOnCreate(rInt),
+				ExpectError: regexp.MustCompile(`Verify on create cannot be set with destination type of email`),
+			},
+			{
+				Config:      testAccTFENotificationConfiguration_genericWithVerifyOnCreate(rInt),
+				ExpectError: regexp.MustCompile(`did not respond with a 2xx status code`),
+			},
+		},
+	})
+}
+
 func TestAccTFENotificationConfiguration_duplicateTriggers(t *testing.T) {
 	notificationConfiguration := &tfe.NotificationConfiguration{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -638,6 +740,32 @@ func testAccCheckTFENotificationConfigurationAttributesSlack(notificationConfigu
 	}
 }
 
+func testAccCheckTFENotificationConfigurationAttributesMicrosoftTeams(notificationConfiguration *tfe.NotificationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if notificationConfiguration.Name != "notification_msteams" {
+			return fmt.Errorf("Bad name: %s", notificationConfiguration.Name)
+		}
+
+		if notificationConfiguration.DestinationType != tfe.NotificationDestinationTypeMicrosoftTeams {
+			return fmt.Errorf("Bad destination type: %s", notificationConfiguration.DestinationType)
+		}
+
+		if notificationConfiguration.Enabled != false {
+			return fmt.Errorf("Bad enabled value: %t", notificationConfiguration.Enabled)
+		}
+
+		if !reflect.DeepEqual(notificationConfiguration.Triggers, []string{}) {
+			return fmt.Errorf("Bad triggers: %v", notificationConfiguration.Triggers)
+		}
+
+		if notificationConfiguration.URL != "http://example.com" {
+			return fmt.Errorf("Bad URL: %s", notificationConfiguration.URL)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTFENotificationConfigurationAttributesDuplicateTriggers(notificationConfiguration *tfe.NotificationConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if notificationConfiguration.Name != "notification_duplicate_triggers" {
@@ -989,6 +1117,148 @@ resource "tfe_notification_configuration" "foobar" {
 }`, rInt)
 }
 
+func testAccTFENotificationConfiguration_microsoftTeams(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_msteams"
+  destination_type = "microsoft-teams"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_assessmentTriggers(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_assessment_triggers"
+  destination_type = "generic"
+  triggers         = ["assessment:drifted", "assessment:failed", "assessment:check_failure"]
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_microsoftTeamsWithEmailAddresses(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_msteams_with_email_addresses"
+  destination_type = "microsoft-teams"
+  email_addresses  = ["test@example.com", "test2@example.com"]
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_microsoftTeamsWithToken(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_msteams_with_token"
+  destination_type = "microsoft-teams"
+  token            = "1234567890"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_microsoftTeamsWithoutURL(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_msteams_without_url"
+  destination_type = "microsoft-teams"
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_emailWithVerifyOnCreate(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_email_with_verify_on_create"
+  destination_type = "email"
+  verify_on_create = true
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
+func testAccTFENotificationConfiguration_genericWithVerifyOnCreate(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_generic_with_verify_on_create"
+  destination_type = "generic"
+  url              = "https://example.com/this-path-does-not-exist"
+  verify_on_create = true
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt)
+}
+
 func testAccTFENotificationConfiguration_duplicateTriggers(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
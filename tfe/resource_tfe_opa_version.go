@@ -0,0 +1,77 @@
+package tfe
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// opaTool manages OPA versions through the admin API.
+var opaTool = policyTool{
+	name: "OPA",
+
+	create: func(client *tfe.Client, options policyToolVersionOptions) (*policyToolVersion, error) {
+		v, err := client.Admin.OPAVersions.Create(ctx, tfe.AdminOPAVersionCreateOptions{
+			Version:          options.Version,
+			URL:              options.URL,
+			SHA:              options.SHA,
+			Official:         tfe.Bool(options.Official),
+			Enabled:          tfe.Bool(options.Enabled),
+			Beta:             tfe.Bool(options.Beta),
+			Deprecated:       tfe.Bool(options.Deprecated),
+			DeprecatedReason: tfe.String(options.DeprecatedReason),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return flattenOPAVersion(v), nil
+	},
+
+	read: func(client *tfe.Client, id string) (*policyToolVersion, error) {
+		v, err := client.Admin.OPAVersions.Read(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return flattenOPAVersion(v), nil
+	},
+
+	update: func(client *tfe.Client, id string, options policyToolVersionOptions) (*policyToolVersion, error) {
+		v, err := client.Admin.OPAVersions.Update(ctx, id, tfe.AdminOPAVersionUpdateOptions{
+			Version:          tfe.String(options.Version),
+			URL:              tfe.String(options.URL),
+			SHA:              tfe.String(options.SHA),
+			Official:         tfe.Bool(options.Official),
+			Enabled:          tfe.Bool(options.Enabled),
+			Beta:             tfe.Bool(options.Beta),
+			Deprecated:       tfe.Bool(options.Deprecated),
+			DeprecatedReason: tfe.String(options.DeprecatedReason),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return flattenOPAVersion(v), nil
+	},
+
+	delete: func(client *tfe.Client, id string) error {
+		return client.Admin.OPAVersions.Delete(ctx, id)
+	},
+
+	fetchID: fetchOPAVersionID,
+}
+
+func resourceTFEOPAVersion() *schema.Resource {
+	return resourceTFEPolicyToolVersion(opaTool)
+}
+
+func flattenOPAVersion(v *tfe.AdminOPAVersion) *policyToolVersion {
+	return &policyToolVersion{
+		ID:               v.ID,
+		Version:          v.Version,
+		URL:              v.URL,
+		SHA:              v.SHA,
+		Official:         v.Official,
+		Enabled:          v.Enabled,
+		Beta:             v.Beta,
+		Deprecated:       v.Deprecated,
+		DeprecatedReason: v.DeprecatedReason,
+	}
+}
@@ -73,6 +73,12 @@ func resourceTFEOrganization() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"assessments_enforced": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -123,6 +129,7 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("owners_team_saml_role_id", org.OwnersTeamSAMLRoleID)
 	d.Set("cost_estimation_enabled", org.CostEstimationEnabled)
 	d.Set("send_passing_statuses_for_untriggered_speculative_plans", org.SendPassingStatusesForUntriggeredSpeculativePlans)
+	d.Set("assessments_enforced", org.AssessmentsEnforced)
 
 	return nil
 }
@@ -166,6 +173,11 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 		options.SendPassingStatusesForUntriggeredSpeculativePlans = tfe.Bool(sendPassingStatusesForUntriggeredSpeculativePlans.(bool))
 	}
 
+	// If assessments_enforced is supplied, set it using the options struct.
+	if assessmentsEnforced, ok := d.GetOkExists("assessments_enforced"); ok {
+		options.AssessmentsEnforced = tfe.Bool(assessmentsEnforced.(bool))
+	}
+
 	log.Printf("[DEBUG] Update configuration of organization: %s", d.Id())
 	org, err := tfeClient.Organizations.Update(ctx, d.Id(), options)
 	if err != nil {
@@ -165,6 +165,40 @@ func TestAccTFEOrganization_update_costEstimation(t *testing.T) {
 	})
 }
 
+func TestAccTFEOrganization_update_assessmentsEnforced(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	org := &tfe.Organization{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganization_assessmentsEnforced(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationExists(
+						"tfe_organization.foobar", org),
+					resource.TestCheckResourceAttr(
+						"tfe_organization.foobar", "assessments_enforced", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFEOrganization_assessmentsEnforced(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationExists(
+						"tfe_organization.foobar", org),
+					resource.TestCheckResourceAttr(
+						"tfe_organization.foobar", "assessments_enforced", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEOrganization_case(t *testing.T) {
 	org := &tfe.Organization{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -397,3 +431,12 @@ resource "tfe_organization" "foobar" {
   cost_estimation_enabled  = %t
 }`, rInt, costEstimationEnabled)
 }
+
+func testAccTFEOrganization_assessmentsEnforced(rInt int, assessmentsEnforced bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name                 = "tst-terraform-%d"
+  email                = "admin@company.com"
+  assessments_enforced = %t
+}`, rInt, assessmentsEnforced)
+}
@@ -1,11 +1,15 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"regexp"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,6 +24,8 @@ func resourceTFEPolicySet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceTFEPolicySetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -92,8 +98,15 @@ func resourceTFEPolicySet() *schema.Resource {
 						},
 
 						"oauth_token_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
+						},
+
+						"github_app_installation_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
 						},
 					},
 				},
@@ -105,6 +118,60 @@ func resourceTFEPolicySet() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"global"},
 			},
+
+			"bundled_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"policy_ids", "vcs_repo", "slug", "policies_path"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"enforcement_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(tfe.EnforcementSoft),
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.EnforcementAdvisory),
+									string(tfe.EnforcementHard),
+									string(tfe.EnforcementSoft),
+								},
+								false,
+							),
+						},
+
+						"source_file": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"modules": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"bundle_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latest_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latest_version_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -141,7 +208,15 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 		options.VCSRepo = &tfe.VCSRepoOptions{
 			Identifier:        tfe.String(vcsRepo["identifier"].(string)),
 			IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
-			OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+		}
+
+		// Set either the OAuth token or the GitHub App installation, depending
+		// on which one is configured.
+		if tokenID, ok := vcsRepo["oauth_token_id"].(string); ok && tokenID != "" {
+			options.VCSRepo.OAuthTokenID = tfe.String(tokenID)
+		}
+		if ghaInstallationID, ok := vcsRepo["github_app_installation_id"].(string); ok && ghaInstallationID != "" {
+			options.VCSRepo.GHAInstallationID = tfe.String(ghaInstallationID)
 		}
 
 		// Only set the branch if one is configured.
@@ -160,16 +235,27 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf(
 			"Error creating policy set %s for organization %s: %v", name, organization, err)
 	}
+
+	// Set the ID before uploading any policies, so the policy set is still
+	// tracked (and tainted) when the policies fail to be ingested.
+	d.SetId(policySet.ID)
+
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	_, hasSlug := d.GetOk("slug")
 	if hasSlug && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(tfeClient, d, policySet.ID)
+		slug := d.Get("slug").(map[string]interface{})
+		err := resourceTFEPolicySetUploadVersion(tfeClient, policySet.ID, slug["source_path"].(string))
 		if err != nil {
 			return err
 		}
 	}
 
-	d.SetId(policySet.ID)
+	if _, ok := d.GetOk("bundled_policy"); ok {
+		err := resourceTFEPolicySetUploadBundle(tfeClient, d, policySet.ID)
+		if err != nil {
+			return err
+		}
+	}
 
 	return resourceTFEPolicySetRead(d, meta)
 }
@@ -177,8 +263,12 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	tfeClient := meta.(*tfe.Client)
 
+	options := &tfe.PolicySetReadOptions{
+		Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetNewestVersion},
+	}
+
 	log.Printf("[DEBUG] Read policy set: %s", d.Id())
-	policySet, err := tfeClient.PolicySets.Read(ctx, d.Id())
+	policySet, err := tfeClient.PolicySets.ReadWithOptions(ctx, d.Id(), options)
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			log.Printf("[DEBUG] Policy set %s does no longer exist", d.Id())
@@ -202,9 +292,10 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	var vcsRepo []interface{}
 	if policySet.VCSRepo != nil {
 		vcsConfig := map[string]interface{}{
-			"identifier":         policySet.VCSRepo.Identifier,
-			"ingress_submodules": policySet.VCSRepo.IngressSubmodules,
-			"oauth_token_id":     policySet.VCSRepo.OAuthTokenID,
+			"identifier":                 policySet.VCSRepo.Identifier,
+			"ingress_submodules":         policySet.VCSRepo.IngressSubmodules,
+			"oauth_token_id":             policySet.VCSRepo.OAuthTokenID,
+			"github_app_installation_id": policySet.VCSRepo.GHAInstallationID,
 		}
 
 		// Get and assert the VCS repo configuration block.
@@ -238,6 +329,16 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("workspace_ids", workspaceIDs)
 
+	// Update the latest version, which only exists for policy sets backed by
+	// a VCS repository or an uploaded slug.
+	var latestVersionID, latestVersionStatus string
+	if policySet.NewestVersion != nil {
+		latestVersionID = policySet.NewestVersion.ID
+		latestVersionStatus = string(policySet.NewestVersion.Status)
+	}
+	d.Set("latest_version_id", latestVersionID)
+	d.Set("latest_version_status", latestVersionStatus)
+
 	return nil
 }
 
@@ -288,7 +389,13 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 				Identifier:        tfe.String(vcsRepo["identifier"].(string)),
 				Branch:            tfe.String(vcsRepo["branch"].(string)),
 				IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
-				OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+			}
+
+			if tokenID, ok := vcsRepo["oauth_token_id"].(string); ok && tokenID != "" {
+				options.VCSRepo.OAuthTokenID = tfe.String(tokenID)
+			}
+			if ghaInstallationID, ok := vcsRepo["github_app_installation_id"].(string); ok && ghaInstallationID != "" {
+				options.VCSRepo.GHAInstallationID = tfe.String(ghaInstallationID)
 			}
 		}
 
@@ -338,7 +445,16 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	if d.HasChange("slug") && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(tfeClient, d, d.Id())
+		slug := d.Get("slug").(map[string]interface{})
+		err := resourceTFEPolicySetUploadVersion(tfeClient, d.Id(), slug["source_path"].(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, hasBundledPolicies := d.GetOk("bundled_policy")
+	if hasBundledPolicies && (d.HasChange("bundled_policy") || d.HasChange("bundle_hash")) {
+		err := resourceTFEPolicySetUploadBundle(tfeClient, d, d.Id())
 		if err != nil {
 			return err
 		}
@@ -401,21 +517,158 @@ func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceData, policySetID string) error {
+func resourceTFEPolicySetUploadVersion(client *tfe.Client, policySetID, path string) error {
 	log.Printf("[DEBUG] Create policy set version for policy set %s.", policySetID)
 	psv, err := client.PolicySetVersions.Create(ctx, policySetID)
 	if err != nil {
 		return fmt.Errorf("Error creating policy set version for policy set %s: %v", policySetID, err)
 	}
 
-	slug := d.Get("slug").(map[string]interface{})
-	path := slug["source_path"].(string)
-
 	log.Printf("[DEBUG] Upload policy set version %s.", psv.ID)
 	err = client.PolicySetVersions.Upload(ctx, *psv, path)
 	if err != nil {
 		return fmt.Errorf("Error uploading policies for policy set version %s: %v", psv.ID, err)
 	}
 
+	// The upload only stores the policies, so wait for them to be ingested
+	// to make sure they could actually be parsed.
+	err = resource.Retry(time.Duration(5)*time.Minute, func() *resource.RetryError {
+		log.Printf("[DEBUG] Read status of policy set version %s.", psv.ID)
+		v, err := client.PolicySetVersions.Read(ctx, psv.ID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		switch v.Status {
+		case tfe.PolicySetVersionReady:
+			return nil
+		case tfe.PolicySetVersionErrored:
+			return resource.NonRetryableError(policySetVersionError(v))
+		default:
+			return resource.RetryableError(
+				fmt.Errorf("policy set version %s is still %s", v.ID, v.Status))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("Error ingesting policies for policy set version %s: %v", psv.ID, err)
+	}
+
+	return nil
+}
+
+// policySetVersionError returns the error reported by the ingestion of an
+// errored policy set version.
+func policySetVersionError(psv *tfe.PolicySetVersion) error {
+	switch {
+	case psv.ErrorMessage != "" && psv.Error != "":
+		return fmt.Errorf("%s: %s", psv.Error, psv.ErrorMessage)
+	case psv.ErrorMessage != "":
+		return fmt.Errorf("%s", psv.ErrorMessage)
+	case psv.Error != "":
+		return fmt.Errorf("%s", psv.Error)
+	default:
+		return fmt.Errorf("policy set version %s errored", psv.ID)
+	}
+}
+
+// resourceTFEPolicySetUploadBundle generates a bundle with a sentinel.hcl
+// configuration from the bundled policies and uploads it as a new version of
+// the policy set.
+func resourceTFEPolicySetUploadBundle(client *tfe.Client, d *schema.ResourceData, policySetID string) error {
+	dir, err := os.MkdirTemp("", "tfe-policy-set-")
+	if err != nil {
+		return fmt.Errorf("Error creating bundle for policy set %s: %v", policySetID, err)
+	}
+	defer os.RemoveAll(dir)
+
+	policies := expandBundledPolicies(d.Get("bundled_policy").([]interface{}))
+
+	log.Printf("[DEBUG] Generate bundle for policy set %s in %s.", policySetID, dir)
+	if err := writePolicySetBundle(dir, policies); err != nil {
+		return fmt.Errorf("Error creating bundle for policy set %s: %v", policySetID, err)
+	}
+
+	return resourceTFEPolicySetUploadVersion(client, policySetID, dir)
+}
+
+// resourceTFEPolicySetCustomizeDiff hashes the files of the bundled policies,
+// so changes to any of those files show up in the plan.
+func resourceTFEPolicySetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	bundled := d.Get("bundled_policy").([]interface{})
+	if len(bundled) == 0 {
+		return nil
+	}
+
+	for i := range bundled {
+		for _, key := range []string{"name", "enforcement_level", "source_file", "modules"} {
+			if !d.NewValueKnown(fmt.Sprintf("bundled_policy.%d.%s", i, key)) {
+				return d.SetNewComputed("bundle_hash")
+			}
+		}
+	}
+
+	bundleHash, err := bundledPoliciesHash(expandBundledPolicies(bundled))
+	if err != nil {
+		return fmt.Errorf("Error hashing bundled policies: %v", err)
+	}
+
+	if bundleHash != d.Get("bundle_hash").(string) {
+		return d.SetNew("bundle_hash", bundleHash)
+	}
+
 	return nil
 }
+
+func expandBundledPolicies(bundled []interface{}) []bundledPolicy {
+	var policies []bundledPolicy
+	for _, v := range bundled {
+		p := v.(map[string]interface{})
+
+		modules := make(map[string]string)
+		for name, path := range p["modules"].(map[string]interface{}) {
+			modules[name] = path.(string)
+		}
+
+		policies = append(policies, bundledPolicy{
+			Name:             p["name"].(string),
+			EnforcementLevel: p["enforcement_level"].(string),
+			SourceFile:       p["source_file"].(string),
+			Modules:          modules,
+		})
+	}
+
+	return policies
+}
+
+// fetchPolicySetByName returns the policy set of an organization with the
+// exact given name.
+func fetchPolicySetByName(client *tfe.Client, organization, name string) (*tfe.PolicySet, error) {
+	// Create an options struct. The search matches partial names, so the
+	// results still need to be compared against the exact name.
+	options := &tfe.PolicySetListOptions{
+		Search: name,
+	}
+
+	for {
+		l, err := client.PolicySets.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving policy sets: %v", err)
+		}
+
+		for _, ps := range l.Items {
+			if ps.Name == name {
+				return ps, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, fmt.Errorf("Could not find policy set %s/%s", organization, name)
+}
@@ -322,6 +322,44 @@ func TestAccTFEPolicySet_vcs(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicySet_vcsGithubAppInstallation(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	policySet := &tfe.PolicySet{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_APP_INSTALLATION_ID == "" {
+				t.Skip("Please set GITHUB_APP_INSTALLATION_ID to run this test")
+			}
+			if GITHUB_POLICY_SET_IDENTIFIER == "" {
+				t.Skip("Please set GITHUB_POLICY_SET_IDENTIFIER to run this test")
+			}
+			if GITHUB_POLICY_SET_PATH == "" {
+				t.Skip("Please set GITHUB_POLICY_SET_PATH to run this test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySet_vcsGithubAppInstallation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "vcs_repo.0.identifier", GITHUB_POLICY_SET_IDENTIFIER),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "vcs_repo.0.github_app_installation_id", GITHUB_APP_INSTALLATION_ID),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "policies_path", GITHUB_POLICY_SET_PATH),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicySet_updateVCSBranch(t *testing.T) {
 	skipIfFreeOnly(t)
 
@@ -429,6 +467,58 @@ func TestAccTFEPolicySet_versionedSlug(t *testing.T) {
 						"tfe_policy_set.foobar", "slug.id"),
 					resource.TestCheckResourceAttr(
 						"tfe_policy_set.foobar", "slug.id", checksum),
+					resource.TestCheckResourceAttrSet(
+						"tfe_policy_set.foobar", "latest_version_id"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "latest_version_status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEPolicySet_versionedSlugInvalid(t *testing.T) {
+	skipIfFreeOnly(t)
+	skipIfUnitTest(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEPolicySet_versionSlug(rInt, "test-fixtures/policy-set-version-invalid"),
+				ExpectError: regexp.MustCompile(`Error ingesting policies for policy set version`),
+			},
+		},
+	})
+}
+
+func TestAccTFEPolicySet_bundledPolicies(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	policySet := &tfe.PolicySet{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySet_bundledPolicies(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "bundled_policy.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "bundled_policy.0.name", "policy-test"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_policy_set.foobar", "bundle_hash"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "latest_version_status", "ready"),
 				),
 			},
 		},
@@ -900,6 +990,31 @@ resource "tfe_policy_set" "foobar" {
 	)
 }
 
+func testAccTFEPolicySet_vcsGithubAppInstallation(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_policy_set" "foobar" {
+  name         = "tst-terraform"
+  description  = "Policy Set"
+  organization = tfe_organization.foobar.id
+  vcs_repo {
+    identifier                 = "%s"
+    github_app_installation_id = "%s"
+  }
+
+  policies_path = "%s"
+}
+`, rInt,
+		GITHUB_POLICY_SET_IDENTIFIER,
+		GITHUB_APP_INSTALLATION_ID,
+		GITHUB_POLICY_SET_PATH,
+	)
+}
+
 func testAccTFEPolicySet_updateVCSBranch(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -1000,3 +1115,35 @@ resource "tfe_policy_set" "foobar" {
   }
 } `, rInt, sourcePath)
 }
+
+func testAccTFEPolicySet_bundledPolicies(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_sentinel_policy" "foobar" {
+  name         = "policy-test"
+  organization = tfe_organization.foobar.id
+  source_file  = "test-fixtures/sentinel-modules/require-tags.sentinel"
+  enforce_mode = "hard-mandatory"
+
+  modules = {
+    tags = "test-fixtures/sentinel-modules/modules/tags.sentinel"
+  }
+}
+
+resource "tfe_policy_set" "foobar" {
+  name         = "tst-terraform"
+  description  = "Policy Set"
+  organization = tfe_organization.foobar.id
+
+  bundled_policy {
+    name              = tfe_sentinel_policy.foobar.name
+    enforcement_level = tfe_sentinel_policy.foobar.enforce_mode
+    source_file       = tfe_sentinel_policy.foobar.source_file
+    modules           = tfe_sentinel_policy.foobar.modules
+  }
+}`, rInt)
+}
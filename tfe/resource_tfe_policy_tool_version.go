@@ -0,0 +1,203 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyToolVersion holds the attributes shared by Sentinel and OPA versions.
+type policyToolVersion struct {
+	ID               string
+	Version          string
+	URL              string
+	SHA              string
+	Official         bool
+	Enabled          bool
+	Beta             bool
+	Deprecated       bool
+	DeprecatedReason *string
+}
+
+// policyToolVersionOptions holds the options to create or update a Sentinel
+// or OPA version.
+type policyToolVersionOptions struct {
+	Version          string
+	URL              string
+	SHA              string
+	Official         bool
+	Enabled          bool
+	Beta             bool
+	Deprecated       bool
+	DeprecatedReason string
+}
+
+// policyTool describes a policy tool whose versions are managed through the
+// admin API, so Sentinel and OPA versions share a single implementation.
+type policyTool struct {
+	// name is the name of the tool used in logs and errors.
+	name string
+
+	create  func(client *tfe.Client, options policyToolVersionOptions) (*policyToolVersion, error)
+	read    func(client *tfe.Client, id string) (*policyToolVersion, error)
+	update  func(client *tfe.Client, id string, options policyToolVersionOptions) (*policyToolVersion, error)
+	delete  func(client *tfe.Client, id string) error
+	fetchID func(version string, client *tfe.Client) (string, error)
+}
+
+func resourceTFEPolicyToolVersion(tool policyTool) *schema.Resource {
+	return &schema.Resource{
+		Create: tool.resourceCreate,
+		Read:   tool.resourceRead,
+		Update: tool.resourceUpdate,
+		Delete: tool.resourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: tool.resourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"official": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"beta": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deprecated_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  nil,
+			},
+		},
+	}
+}
+
+func (tool policyTool) resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	opts := expandPolicyToolVersionOptions(d)
+
+	log.Printf("[DEBUG] Create new %s version: %s", tool.name, opts.Version)
+	v, err := tool.create(tfeClient, opts)
+	if err != nil {
+		return fmt.Errorf("Error creating the new %s version %s: %v", tool.name, opts.Version, err)
+	}
+
+	d.SetId(v.ID)
+
+	return tool.resourceUpdate(d, meta)
+}
+
+func (tool policyTool) resourceRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read configuration of %s version: %s", tool.name, d.Id())
+	v, err := tool.read(tfeClient, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] %s version %s does no longer exist", tool.name, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("version", v.Version)
+	d.Set("url", v.URL)
+	d.Set("sha", v.SHA)
+	d.Set("official", v.Official)
+	d.Set("enabled", v.Enabled)
+	d.Set("beta", v.Beta)
+	d.Set("deprecated", v.Deprecated)
+	d.Set("deprecated_reason", v.DeprecatedReason)
+
+	return nil
+}
+
+func (tool policyTool) resourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Update configuration of %s version: %s", tool.name, d.Id())
+	v, err := tool.update(tfeClient, d.Id(), expandPolicyToolVersionOptions(d))
+	if err != nil {
+		return fmt.Errorf("Error updating %s version %s: %v", tool.name, d.Id(), err)
+	}
+
+	d.SetId(v.ID)
+
+	return tool.resourceRead(d, meta)
+}
+
+func (tool policyTool) resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Delete %s version: %s", tool.name, d.Id())
+	err := tool.delete(tfeClient, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting %s version %s: %v", tool.name, d.Id(), err)
+	}
+
+	return nil
+}
+
+func (tool policyTool) resourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(*tfe.Client)
+
+	// Splitting by '-' and checking if the first elem is equal to tool
+	// determines if the string is a tool version ID
+	s := strings.Split(d.Id(), "-")
+	if s[0] != "tool" {
+		versionID, err := tool.fetchID(d.Id(), tfeClient)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving %s version %s: %w", tool.name, d.Id(), err)
+		}
+
+		d.SetId(versionID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandPolicyToolVersionOptions(d *schema.ResourceData) policyToolVersionOptions {
+	return policyToolVersionOptions{
+		Version:          d.Get("version").(string),
+		URL:              d.Get("url").(string),
+		SHA:              d.Get("sha").(string),
+		Official:         d.Get("official").(bool),
+		Enabled:          d.Get("enabled").(bool),
+		Beta:             d.Get("beta").(bool),
+		Deprecated:       d.Get("deprecated").(bool),
+		DeprecatedReason: d.Get("deprecated_reason").(string),
+	}
+}
@@ -0,0 +1,156 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEPolicyToolVersion_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	cases := map[string]struct {
+		tool         policyTool
+		resourceType string
+	}{
+		"sentinel": {tool: sentinelTool, resourceType: "tfe_sentinel_version"},
+		"opa":      {tool: opaTool, resourceType: "tfe_opa_version"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			toolVersion := &policyToolVersion{}
+			sha := genSha(t, "secret", "data")
+			rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+			version := genVersion(rInt)
+			resourceName := tc.resourceType + ".foobar"
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:     func() { testAccPreCheck(t) },
+				Providers:    testAccProviders,
+				CheckDestroy: testAccCheckTFEPolicyToolVersionDestroy(tc.tool, tc.resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccTFEPolicyToolVersion_basic(tc.resourceType, version, sha),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckTFEPolicyToolVersionExists(tc.tool, resourceName, toolVersion),
+							resource.TestCheckResourceAttr(
+								resourceName, "version", version),
+							resource.TestCheckResourceAttr(
+								resourceName, "url", "https://www.hashicorp.com"),
+							resource.TestCheckResourceAttr(
+								resourceName, "sha", sha),
+							resource.TestCheckResourceAttr(
+								resourceName, "enabled", "true"),
+						),
+					},
+
+					{
+						Config: testAccTFEPolicyToolVersion_full(tc.resourceType, version, sha),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckTFEPolicyToolVersionExists(tc.tool, resourceName, toolVersion),
+							resource.TestCheckResourceAttr(
+								resourceName, "beta", "true"),
+							resource.TestCheckResourceAttr(
+								resourceName, "deprecated", "true"),
+							resource.TestCheckResourceAttr(
+								resourceName, "deprecated_reason", "foobar"),
+						),
+					},
+
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateId:     version,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckTFEPolicyToolVersionDestroy(tool policyTool, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if rs.Primary.ID == "" {
+				return fmt.Errorf("No instance ID is set")
+			}
+
+			_, err := tool.read(tfeClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("%s version %s still exists", tool.name, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEPolicyToolVersionExists(tool policyTool, n string, toolVersion *policyToolVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		v, err := tool.read(tfeClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if v.ID != rs.Primary.ID {
+			return fmt.Errorf("%s version not found", tool.name)
+		}
+
+		*toolVersion = *v
+
+		return nil
+	}
+}
+
+func testAccTFEPolicyToolVersion_basic(resourceType string, version string, sha string) string {
+	return fmt.Sprintf(`
+resource "%s" "foobar" {
+  version = "%s"
+  url     = "https://www.hashicorp.com"
+  sha     = "%s"
+}`, resourceType, version, sha)
+}
+
+func testAccTFEPolicyToolVersion_full(resourceType string, version string, sha string) string {
+	return fmt.Sprintf(`
+resource "%s" "foobar" {
+  version           = "%s"
+  url               = "https://www.hashicorp.com"
+  sha               = "%s"
+  official          = false
+  enabled           = true
+  beta              = true
+  deprecated        = true
+  deprecated_reason = "foobar"
+}`, resourceType, version, sha)
+}
@@ -0,0 +1,122 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEProjectCreate,
+		Read:   resourceTFEProjectRead,
+		Update: resourceTFEProjectUpdate,
+		Delete: resourceTFEProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 40),
+					validation.StringMatch(
+						regexp.MustCompile(`\A[\w\-][\w\- ]+[\w\-]\z`),
+						"can only include letters, numbers, spaces, -, and _.",
+					),
+				),
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFEProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	// Create a new options struct.
+	options := tfe.ProjectCreateOptions{
+		Name: name,
+	}
+
+	log.Printf("[DEBUG] Create new project for organization: %s", organization)
+	project, err := tfeClient.Projects.Create(ctx, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating project %s for organization %s: %v", name, organization, err)
+	}
+
+	d.SetId(project.ID)
+
+	return resourceTFEProjectRead(d, meta)
+}
+
+func resourceTFEProjectRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read configuration of project: %s", d.Id())
+	project, err := tfeClient.Projects.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Project %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of project %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("name", project.Name)
+	if project.Organization != nil {
+		d.Set("organization", project.Organization.Name)
+	}
+
+	return nil
+}
+
+func resourceTFEProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Create a new options struct.
+	options := tfe.ProjectUpdateOptions{
+		Name: tfe.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Update project: %s", d.Id())
+	_, err := tfeClient.Projects.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating project %s: %v", d.Id(), err)
+	}
+
+	return resourceTFEProjectRead(d, meta)
+}
+
+func resourceTFEProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Delete project: %s", d.Id())
+	err := tfeClient.Projects.Delete(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting project %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEProject_basic(t *testing.T) {
+	project := &tfe.Project{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProject_basic(rInt, "project-test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectExists(
+						"tfe_project.foobar", project),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "name", "project-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "organization", fmt.Sprintf("tst-terraform-%d", rInt)),
+				),
+			},
+
+			{
+				Config: testAccTFEProject_basic(rInt, "project updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectExists(
+						"tfe_project.foobar", project),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "name", "project updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEProject_import(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProject_basic(rInt, "project-test"),
+			},
+
+			{
+				ResourceName:      "tfe_project.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEProjectExists(
+	n string, project *tfe.Project) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		p, err := tfeClient.Projects.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*project = *p
+
+		return nil
+	}
+}
+
+func testAccCheckTFEProjectDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_project" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.Projects.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Project %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEProject_basic(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "%s"
+  organization = tfe_organization.foobar.id
+}`, rInt, name)
+}
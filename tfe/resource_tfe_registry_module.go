@@ -23,7 +23,9 @@ func resourceTFERegistryModule() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 			"module_provider": {
 				Type:     schema.TypeString,
@@ -52,9 +54,16 @@ func resourceTFERegistryModule() *schema.Resource {
 							ForceNew: true,
 						},
 						"oauth_token_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
+						},
+						"github_app_installation_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
 						},
 					},
 				},
@@ -75,9 +84,24 @@ func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) e
 
 		options.VCSRepo = &tfe.RegistryModuleVCSRepoOptions{
 			Identifier:        tfe.String(vcsRepo["identifier"].(string)),
-			OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
 			DisplayIdentifier: tfe.String(vcsRepo["display_identifier"].(string)),
 		}
+
+		if tokenID, ok := vcsRepo["oauth_token_id"].(string); ok && tokenID != "" {
+			options.VCSRepo.OAuthTokenID = tfe.String(tokenID)
+		}
+
+		// Modules backed by a GitHub App installation are created within an
+		// explicit organization instead of the one owning the OAuth token.
+		if ghaInstallationID, ok := vcsRepo["github_app_installation_id"].(string); ok && ghaInstallationID != "" {
+			organization, ok := d.GetOk("organization")
+			if !ok {
+				return fmt.Errorf("organization must be set when using vcs_repo.github_app_installation_id")
+			}
+
+			options.VCSRepo.GHAInstallationID = tfe.String(ghaInstallationID)
+			options.VCSRepo.OrganizationName = tfe.String(organization.(string))
+		}
 	}
 
 	log.Printf("[DEBUG] Create registry module from repository %s", *options.VCSRepo.Identifier)
@@ -149,9 +173,10 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 	var vcsRepo []interface{}
 	if registryModule.VCSRepo != nil {
 		vcsConfig := map[string]interface{}{
-			"identifier":         registryModule.VCSRepo.Identifier,
-			"oauth_token_id":     registryModule.VCSRepo.OAuthTokenID,
-			"display_identifier": registryModule.VCSRepo.DisplayIdentifier,
+			"identifier":                 registryModule.VCSRepo.Identifier,
+			"oauth_token_id":             registryModule.VCSRepo.OAuthTokenID,
+			"github_app_installation_id": registryModule.VCSRepo.GHAInstallationID,
+			"display_identifier":         registryModule.VCSRepo.DisplayIdentifier,
 		}
 		vcsRepo = append(vcsRepo, vcsConfig)
 
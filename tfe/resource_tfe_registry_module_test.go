@@ -50,6 +50,43 @@ func TestAccTFERegistryModule_vcs(t *testing.T) {
 	})
 }
 
+func TestAccTFERegistryModule_vcsGithubAppInstallation(t *testing.T) {
+	registryModule := &tfe.RegistryModule{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_APP_INSTALLATION_ID == "" {
+				t.Skip("Please set GITHUB_APP_INSTALLATION_ID to run this test")
+			}
+			if GITHUB_REGISTRY_MODULE_IDENTIFIER == "" {
+				t.Skip("Please set GITHUB_REGISTRY_MODULE_IDENTIFIER to run this test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModule_vcsGithubAppInstallation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryModuleExists(
+						"tfe_registry_module.foobar", orgName, registryModule),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "organization", orgName),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "name", getRegistryModuleName()),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "vcs_repo.0.identifier", GITHUB_REGISTRY_MODULE_IDENTIFIER),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "vcs_repo.0.github_app_installation_id", GITHUB_APP_INSTALLATION_ID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFERegistryModule_emptyVCSRepo(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -260,6 +297,28 @@ resource "tfe_registry_module" "foobar" {
 		GITHUB_REGISTRY_MODULE_IDENTIFIER)
 }
 
+func testAccTFERegistryModule_vcsGithubAppInstallation(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+ name  = "tst-terraform-%d"
+ email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "foobar" {
+ organization = tfe_organization.foobar.name
+
+ vcs_repo {
+   display_identifier         = "%s"
+   identifier                 = "%s"
+   github_app_installation_id = "%s"
+ }
+}`,
+		rInt,
+		GITHUB_REGISTRY_MODULE_IDENTIFIER,
+		GITHUB_REGISTRY_MODULE_IDENTIFIER,
+		GITHUB_APP_INSTALLATION_ID)
+}
+
 func testAccTFERegistryModule_emptyVCSRepo(rInt int, token string) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
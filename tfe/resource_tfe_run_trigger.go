@@ -50,10 +50,24 @@ func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Create run trigger on workspace %s with sourceable %s", workspaceID, sourceableID)
+	runTrigger, err := createRunTrigger(tfeClient, workspaceID, options)
+	if err != nil {
+		return fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %v", workspaceID, sourceableID, err)
+	}
+
+	d.SetId(runTrigger.ID)
+
+	return resourceTFERunTriggerRead(d, meta)
+}
+
+// createRunTrigger creates a run trigger, retrying while the creation of run
+// triggers is locked for the workspace.
+func createRunTrigger(client *tfe.Client, workspaceID string, options tfe.RunTriggerCreateOptions) (*tfe.RunTrigger, error) {
+	var runTrigger *tfe.RunTrigger
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		runTrigger, err := tfeClient.RunTriggers.Create(ctx, workspaceID, options)
+		var err error
+		runTrigger, err = client.RunTriggers.Create(ctx, workspaceID, options)
 		if err == nil {
-			d.SetId(runTrigger.ID)
 			return nil
 		}
 
@@ -65,11 +79,7 @@ func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error
 		return resource.NonRetryableError(err)
 	})
 
-	if err != nil {
-		return fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %v", workspaceID, sourceableID, err)
-	}
-
-	return resourceTFERunTriggerRead(d, meta)
+	return runTrigger, err
 }
 
 func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
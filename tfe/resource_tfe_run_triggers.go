@@ -0,0 +1,191 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxRunTriggerSources is the maximum number of source workspaces a single
+// workspace can have run triggers from.
+const maxRunTriggerSources = 20
+
+func resourceTFERunTriggers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFERunTriggersUpdate,
+		Read:   resourceTFERunTriggersRead,
+		Update: resourceTFERunTriggersUpdate,
+		Delete: resourceTFERunTriggersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("sourceable_ids") {
+				return nil
+			}
+
+			if n := d.Get("sourceable_ids").(*schema.Set).Len(); n > maxRunTriggerSources {
+				return fmt.Errorf(
+					"a workspace can have run triggers from at most %d source workspaces, got %d in sourceable_ids",
+					maxRunTriggerSources, n)
+			}
+
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sourceable_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"run_trigger_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFERunTriggersRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read run triggers of workspace: %s", d.Id())
+	runTriggers, err := listInboundRunTriggers(tfeClient, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading run triggers of workspace %s: %v", d.Id(), err)
+	}
+
+	// Set all sources, including the ones not created by Terraform, so they
+	// show up as changes.
+	var sourceableIDs []interface{}
+	runTriggerIDs := make(map[string]interface{})
+	for sourceableID, runTriggerID := range runTriggers {
+		sourceableIDs = append(sourceableIDs, sourceableID)
+		runTriggerIDs[sourceableID] = runTriggerID
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("sourceable_ids", sourceableIDs)
+	d.Set("run_trigger_ids", runTriggerIDs)
+
+	return nil
+}
+
+func resourceTFERunTriggersUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	// Compare against the current run triggers instead of the prior state, so
+	// run triggers created or deleted outside of Terraform are handled too.
+	runTriggers, err := listInboundRunTriggers(tfeClient, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error reading run triggers of workspace %s: %v", workspaceID, err)
+	}
+
+	sourceableIDs := make(map[string]bool)
+	for _, sourceableID := range d.Get("sourceable_ids").(*schema.Set).List() {
+		sourceableIDs[sourceableID.(string)] = true
+	}
+
+	// Delete the run triggers of removed sources first, to stay within the
+	// limit of sources while adding the new ones.
+	for sourceableID, runTriggerID := range runTriggers {
+		if sourceableIDs[sourceableID] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete run trigger: %s", runTriggerID)
+		err := tfeClient.RunTriggers.Delete(ctx, runTriggerID)
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting run trigger %s: %v", runTriggerID, err)
+		}
+	}
+
+	for sourceableID := range sourceableIDs {
+		if _, ok := runTriggers[sourceableID]; ok {
+			continue
+		}
+
+		options := tfe.RunTriggerCreateOptions{
+			Sourceable: &tfe.Workspace{
+				ID: sourceableID,
+			},
+		}
+
+		log.Printf("[DEBUG] Create run trigger on workspace %s with sourceable %s", workspaceID, sourceableID)
+		_, err := createRunTrigger(tfeClient, workspaceID, options)
+		if err != nil {
+			return fmt.Errorf(
+				"Error creating run trigger on workspace %s with sourceable %s: %v", workspaceID, sourceableID, err)
+		}
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFERunTriggersRead(d, meta)
+}
+
+func resourceTFERunTriggersDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	for _, runTriggerID := range d.Get("run_trigger_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Delete run trigger: %s", runTriggerID)
+		err := tfeClient.RunTriggers.Delete(ctx, runTriggerID.(string))
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting run trigger %s: %v", runTriggerID, err)
+		}
+	}
+
+	return nil
+}
+
+// listInboundRunTriggers returns the IDs of the run triggers of a workspace,
+// by the ID of their source workspace.
+func listInboundRunTriggers(client *tfe.Client, workspaceID string) (map[string]string, error) {
+	runTriggers := make(map[string]string)
+
+	options := &tfe.RunTriggerListOptions{
+		RunTriggerType: tfe.RunTriggerInbound,
+	}
+	for {
+		l, err := client.RunTriggers.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, runTrigger := range l.Items {
+			if runTrigger.Sourceable != nil {
+				runTriggers[runTrigger.Sourceable.ID] = runTrigger.ID
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return runTriggers, nil
+}
@@ -0,0 +1,129 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFERunTriggers_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERunTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunTriggers_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_run_triggers.foobar", "id", "tfe_workspace.workspace", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_run_triggers.foobar", "sourceable_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_run_triggers.foobar", "run_trigger_ids.%", "2"),
+				),
+			},
+			{
+				Config: testAccTFERunTriggers_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_run_triggers.foobar", "sourceable_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_run_triggers.foobar", "run_trigger_ids.%", "1"),
+				),
+			},
+			{
+				ResourceName:      "tfe_run_triggers.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTFERunTriggers_tooManySources(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFERunTriggers_tooManySources(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`at most 20 source workspaces, got 21`),
+			},
+		},
+	})
+}
+
+func testAccTFERunTriggers_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "workspace" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "sourceable" {
+  count        = 3
+  name         = "sourceable-test-${count.index}"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_run_triggers" "foobar" {
+  workspace_id = tfe_workspace.workspace.id
+  sourceable_ids = [
+    tfe_workspace.sourceable[0].id,
+    tfe_workspace.sourceable[1].id,
+  ]
+}`, rInt)
+}
+
+func testAccTFERunTriggers_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "workspace" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "sourceable" {
+  count        = 3
+  name         = "sourceable-test-${count.index}"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_run_triggers" "foobar" {
+  workspace_id   = tfe_workspace.workspace.id
+  sourceable_ids = [tfe_workspace.sourceable[2].id]
+}`, rInt)
+}
+
+func testAccTFERunTriggers_tooManySources(rInt int) string {
+	sourceableIDs := make([]string, maxRunTriggerSources+1)
+	for i := range sourceableIDs {
+		sourceableIDs[i] = fmt.Sprintf(`"ws-%d"`, i)
+	}
+
+	return fmt.Sprintf(`
+resource "tfe_run_triggers" "foobar" {
+  workspace_id   = "ws-%d"
+  sourceable_ids = [%s]
+}`, rInt, strings.Join(sourceableIDs, ", "))
+}
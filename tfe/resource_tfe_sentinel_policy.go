@@ -1,8 +1,10 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -20,6 +22,8 @@ func resourceTFESentinelPolicy() *schema.Resource {
 			State: resourceTFESentinelPolicyImporter,
 		},
 
+		CustomizeDiff: resourceTFESentinelPolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -40,8 +44,27 @@ func resourceTFESentinelPolicy() *schema.Resource {
 			},
 
 			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"policy", "source_file"},
+			},
+
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"policy", "source_file"},
+			},
+
+			"modules": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"content_hash": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
 
 			"enforce_mode": {
@@ -130,6 +153,21 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 	}
 	d.Set("policy", string(content))
 
+	// The content hash is normally computed when planning, so it is only
+	// missing after an import, when there are no known modules yet.
+	if d.Get("content_hash").(string) == "" {
+		modules := make(map[string]string)
+		for name, path := range d.Get("modules").(map[string]interface{}) {
+			modules[name] = path.(string)
+		}
+
+		contentHash, err := sentinelContentHash(string(content), modules)
+		if err != nil {
+			return fmt.Errorf("Error hashing sentinel policy %s: %v", d.Id(), err)
+		}
+		d.Set("content_hash", contentHash)
+	}
+
 	return nil
 }
 
@@ -188,6 +226,55 @@ func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceTFESentinelPolicyCustomizeDiff loads the policy from its source file
+// and hashes it together with its modules, so changes to any of those files
+// show up in the plan.
+func resourceTFESentinelPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_file") {
+		if err := d.SetNewComputed("policy"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("content_hash")
+	}
+
+	if !d.NewValueKnown("modules") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	if sourceFile, ok := d.GetOk("source_file"); ok {
+		content, err := os.ReadFile(sourceFile.(string))
+		if err != nil {
+			return fmt.Errorf("Error reading policy source file %s: %v", sourceFile, err)
+		}
+
+		if string(content) != d.Get("policy").(string) {
+			if err := d.SetNew("policy", string(content)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.NewValueKnown("policy") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	modules := make(map[string]string)
+	for name, path := range d.Get("modules").(map[string]interface{}) {
+		modules[name] = path.(string)
+	}
+
+	contentHash, err := sentinelContentHash(d.Get("policy").(string), modules)
+	if err != nil {
+		return fmt.Errorf("Error hashing sentinel policy: %v", err)
+	}
+
+	if contentHash != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", contentHash)
+	}
+
+	return nil
+}
+
 func resourceTFESentinelPolicyImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := strings.SplitN(d.Id(), "/", 2)
 	if len(s) != 2 {
@@ -203,3 +290,36 @@ func resourceTFESentinelPolicyImporter(d *schema.ResourceData, meta interface{})
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// fetchPolicyByName returns the policy of an organization with the exact
+// given name.
+func fetchPolicyByName(client *tfe.Client, organization, name string) (*tfe.Policy, error) {
+	// Create an options struct. The search matches partial names, so the
+	// results still need to be compared against the exact name.
+	options := &tfe.PolicyListOptions{
+		Search: name,
+	}
+
+	for {
+		l, err := client.Policies.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving policies: %v", err)
+		}
+
+		for _, p := range l.Items {
+			if p.Name == name {
+				return p, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, fmt.Errorf("Could not find policy %s/%s", organization, name)
+}
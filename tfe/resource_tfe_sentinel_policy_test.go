@@ -86,6 +86,34 @@ func TestAccTFESentinelPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccTFESentinelPolicy_sourceFile(t *testing.T) {
+	policy := &tfe.Policy{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFESentinelPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESentinelPolicy_sourceFile(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFESentinelPolicyExists(
+						"tfe_sentinel_policy.foobar", policy),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_policy.foobar", "policy",
+						"import \"tags\"\n\nmain = rule { tags.required is empty }\n"),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_policy.foobar", "modules.tags",
+						"test-fixtures/sentinel-modules/modules/tags.sentinel"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_sentinel_policy.foobar", "content_hash"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFESentinelPolicy_import(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -219,3 +247,22 @@ resource "tfe_sentinel_policy" "foobar" {
   enforce_mode = "soft-mandatory"
 }`, rInt)
 }
+
+func testAccTFESentinelPolicy_sourceFile(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_sentinel_policy" "foobar" {
+  name         = "policy-test"
+  organization = tfe_organization.foobar.id
+  source_file  = "test-fixtures/sentinel-modules/require-tags.sentinel"
+  enforce_mode = "hard-mandatory"
+
+  modules = {
+    tags = "test-fixtures/sentinel-modules/modules/tags.sentinel"
+  }
+}`, rInt)
+}
@@ -0,0 +1,77 @@
+package tfe
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sentinelTool manages Sentinel versions through the admin API.
+var sentinelTool = policyTool{
+	name: "Sentinel",
+
+	create: func(client *tfe.Client, options policyToolVersionOptions) (*policyToolVersion, error) {
+		v, err := client.Admin.SentinelVersions.Create(ctx, tfe.AdminSentinelVersionCreateOptions{
+			Version:          options.Version,
+			URL:              options.URL,
+			SHA:              options.SHA,
+			Official:         tfe.Bool(options.Official),
+			Enabled:          tfe.Bool(options.Enabled),
+			Beta:             tfe.Bool(options.Beta),
+			Deprecated:       tfe.Bool(options.Deprecated),
+			DeprecatedReason: tfe.String(options.DeprecatedReason),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return flattenSentinelVersion(v), nil
+	},
+
+	read: func(client *tfe.Client, id string) (*policyToolVersion, error) {
+		v, err := client.Admin.SentinelVersions.Read(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return flattenSentinelVersion(v), nil
+	},
+
+	update: func(client *tfe.Client, id string, options policyToolVersionOptions) (*policyToolVersion, error) {
+		v, err := client.Admin.SentinelVersions.Update(ctx, id, tfe.AdminSentinelVersionUpdateOptions{
+			Version:          tfe.String(options.Version),
+			URL:              tfe.String(options.URL),
+			SHA:              tfe.String(options.SHA),
+			Official:         tfe.Bool(options.Official),
+			Enabled:          tfe.Bool(options.Enabled),
+			Beta:             tfe.Bool(options.Beta),
+			Deprecated:       tfe.Bool(options.Deprecated),
+			DeprecatedReason: tfe.String(options.DeprecatedReason),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return flattenSentinelVersion(v), nil
+	},
+
+	delete: func(client *tfe.Client, id string) error {
+		return client.Admin.SentinelVersions.Delete(ctx, id)
+	},
+
+	fetchID: fetchSentinelVersionID,
+}
+
+func resourceTFESentinelVersion() *schema.Resource {
+	return resourceTFEPolicyToolVersion(sentinelTool)
+}
+
+func flattenSentinelVersion(v *tfe.AdminSentinelVersion) *policyToolVersion {
+	return &policyToolVersion{
+		ID:               v.ID,
+		Version:          v.Version,
+		URL:              v.URL,
+		SHA:              v.SHA,
+		Official:         v.Official,
+		Enabled:          v.Enabled,
+		Beta:             v.Beta,
+		Deprecated:       v.Deprecated,
+		DeprecatedReason: v.DeprecatedReason,
+	}
+}
@@ -50,71 +50,7 @@ func resourceTFETeamAccess() *schema.Resource {
 				),
 			},
 
-			"permissions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"runs": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice(
-								[]string{
-									string(tfe.RunsPermissionRead),
-									string(tfe.RunsPermissionPlan),
-									string(tfe.RunsPermissionApply),
-								},
-								false,
-							),
-						},
-
-						"variables": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice(
-								[]string{
-									string(tfe.VariablesPermissionNone),
-									string(tfe.VariablesPermissionRead),
-									string(tfe.VariablesPermissionWrite),
-								},
-								false,
-							),
-						},
-
-						"state_versions": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice(
-								[]string{
-									string(tfe.StateVersionsPermissionNone),
-									string(tfe.StateVersionsPermissionReadOutputs),
-									string(tfe.StateVersionsPermissionRead),
-									string(tfe.StateVersionsPermissionWrite),
-								},
-								false,
-							),
-						},
-
-						"sentinel_mocks": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice(
-								[]string{
-									string(tfe.SentinelMocksPermissionNone),
-									string(tfe.SentinelMocksPermissionRead),
-								},
-								false,
-							),
-						},
-
-						"workspace_locking": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-					},
-				},
-			},
+			"permissions": resourceTFETeamAccessPermissionsSchema(),
 
 			"team_id": {
 				Type:     schema.TypeString,
@@ -135,6 +71,76 @@ func resourceTFETeamAccess() *schema.Resource {
 	}
 }
 
+// resourceTFETeamAccessPermissionsSchema returns the schema of the custom
+// workspace permissions granted to a team.
+func resourceTFETeamAccessPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"runs": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.RunsPermissionRead),
+							string(tfe.RunsPermissionPlan),
+							string(tfe.RunsPermissionApply),
+						},
+						false,
+					),
+				},
+
+				"variables": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.VariablesPermissionNone),
+							string(tfe.VariablesPermissionRead),
+							string(tfe.VariablesPermissionWrite),
+						},
+						false,
+					),
+				},
+
+				"state_versions": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.StateVersionsPermissionNone),
+							string(tfe.StateVersionsPermissionReadOutputs),
+							string(tfe.StateVersionsPermissionRead),
+							string(tfe.StateVersionsPermissionWrite),
+						},
+						false,
+					),
+				},
+
+				"sentinel_mocks": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.SentinelMocksPermissionNone),
+							string(tfe.SentinelMocksPermissionRead),
+						},
+						false,
+					),
+				},
+
+				"workspace_locking": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error {
 	tfeClient := meta.(*tfe.Client)
 
@@ -352,6 +358,21 @@ func resourceTFETeamAccessImporter(d *schema.ResourceData, meta interface{}) ([]
 // limitations, rooting out the user's intentions to figure out when to automatically assign 'access' to custom and/or
 // recompute 'permissions'.
 func setCustomOrComputedPermissions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setCustomOrComputedAccessPermissions(d, string(tfe.AccessCustom), teamAccessPermissions)
+}
+
+// teamAccessPermissions lists the attributes of a team access permissions block.
+var teamAccessPermissions = []string{
+	"permissions.0.runs",
+	"permissions.0.variables",
+	"permissions.0.state_versions",
+	"permissions.0.sentinel_mocks",
+	"permissions.0.workspace_locking",
+}
+
+// setCustomOrComputedAccessPermissions implements setCustomOrComputedPermissions for any resource with an 'access'
+// attribute and a 'permissions' block, using the given custom access level and permission attributes.
+func setCustomOrComputedAccessPermissions(d *schema.ResourceDiff, customAccess string, permissions []string) error {
 	if _, ok := d.GetOk("access"); ok {
 		if d.HasChange("access") {
 			// If access is being added or changed to a known value, all permissions
@@ -364,7 +385,7 @@ func setCustomOrComputedPermissions(_ context.Context, d *schema.ResourceDiff, m
 				// changed, the user might be switching from using a fixed access level
 				// (read/plan/write/admin) to a permissions block ('custom' access).
 				// Set the access to custom.
-				if err := setCustomAccess(d); err != nil {
+				if err := setCustomAccess(d, customAccess, permissions); err != nil {
 					return err
 				}
 			}
@@ -381,7 +402,7 @@ func setCustomOrComputedPermissions(_ context.Context, d *schema.ResourceDiff, m
 					// If the resource is new, the value for access isn't known, and permissions are
 					// present, the user must be creating a new resource with custom access.
 					//Set access to custom.
-					if err := setCustomAccess(d); err != nil {
+					if err := setCustomAccess(d, customAccess, permissions); err != nil {
 						return err
 					}
 				}
@@ -392,18 +413,18 @@ func setCustomOrComputedPermissions(_ context.Context, d *schema.ResourceDiff, m
 	return nil
 }
 
-func setCustomAccess(d *schema.ResourceDiff) error {
+func setCustomAccess(d *schema.ResourceDiff, customAccess string, permissions []string) error {
 	// If a change in permissions contains a value not known at plan time, error.
 	// Interpolated values not known at plan time are not allowed because we cannot re-check
 	// for a change in permissions later - when the plan is expanded for new values learned during
 	// an apply. This creates an inconsistent final plan and causes an error.
-	for _, permission := range []string{"permissions.0.runs", "permissions.0.variables", "permissions.0.state_versions", "permissions.0.sentinel_mocks", "permissions.0.workspace_locking"} {
+	for _, permission := range permissions {
 		if !d.NewValueKnown(permission) {
 			return fmt.Errorf("'%q' cannot be derived from a value that is unknown during planning", permission)
 		}
 	}
 
-	d.SetNew("access", tfe.AccessCustom)
+	d.SetNew("access", customAccess)
 
 	return nil
 }
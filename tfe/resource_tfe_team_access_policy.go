@@ -0,0 +1,490 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFETeamAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFETeamAccessPolicyCreate,
+		Read:   resourceTFETeamAccessPolicyRead,
+		Update: resourceTFETeamAccessPolicyUpdate,
+		Delete: resourceTFETeamAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFETeamAccessPolicyImporter,
+		},
+
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			err := setCustomOrComputedPermissions(c, d, meta)
+			if err != nil {
+				return err
+			}
+
+			err = reconcileTeamAccessPolicyWorkspaces(c, d, meta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				// This should be moved to the Resource level when possible:
+				// https://github.com/hashicorp/terraform-plugin-sdk/issues/470
+				ExactlyOneOf: []string{"access", "permissions"},
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.AccessAdmin),
+						string(tfe.AccessRead),
+						string(tfe.AccessPlan),
+						string(tfe.AccessWrite),
+					},
+					false,
+				),
+			},
+
+			"permissions": resourceTFETeamAccessPermissionsSchema(),
+
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_names": {
+				Type:         schema.TypeList,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				AtLeastOneOf: []string{"workspace_names", "workspace_tag_names"},
+			},
+
+			"workspace_tag_names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+
+			"workspace_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"team_access_ids": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"drifted_workspace_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFETeamAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	teamID := d.Get("team_id").(string)
+	organization := d.Get("organization").(string)
+
+	// Both parts of the ID force a new resource, so it never goes stale.
+	// The ID is set first, so any access granted before an error is tracked.
+	d.SetId(organization + "/" + teamID)
+
+	if err := resourceTFETeamAccessPolicyReconcile(tfeClient, d, map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	return resourceTFETeamAccessPolicyRead(d, meta)
+}
+
+func resourceTFETeamAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	teamID := d.Get("team_id").(string)
+	organization := d.Get("organization").(string)
+
+	log.Printf("[DEBUG] Read team %s", teamID)
+	_, err := tfeClient.Teams.Read(ctx, teamID)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Team %s does no longer exist", teamID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading team %s: %v", teamID, err)
+	}
+
+	workspaces, err := listWorkspacesBySelector(
+		tfeClient, organization, teamAccessPolicyWorkspaceNames(d), teamAccessPolicyWorkspaceTagNames(d), "")
+	if err != nil {
+		return err
+	}
+
+	workspaceIDs := make(map[string]bool)
+	for _, w := range workspaces {
+		workspaceIDs[w.ID] = true
+	}
+
+	// Only the access granted by this resource is tracked, so access managed
+	// in any other way is never changed or removed.
+	teamAccessIDs := make(map[string]interface{})
+	var drifted []interface{}
+	for workspaceID, id := range d.Get("team_access_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Read team access: %s", id)
+		tmAccess, err := tfeClient.TeamAccess.Read(ctx, id.(string))
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				log.Printf("[DEBUG] Team access %s does no longer exist", id)
+				continue
+			}
+			return fmt.Errorf("Error reading team access %s: %v", id, err)
+		}
+
+		teamAccessIDs[workspaceID] = tmAccess.ID
+
+		// Record access that no longer matches the configured access level
+		// or permissions, so the drift shows up as a pending change.
+		if workspaceIDs[workspaceID] && !teamAccessPolicyMatches(d, tmAccess) {
+			drifted = append(drifted, workspaceID)
+		}
+	}
+
+	var ids []interface{}
+	for id := range workspaceIDs {
+		ids = append(ids, id)
+	}
+
+	d.Set("workspace_ids", ids)
+	d.Set("team_access_ids", teamAccessIDs)
+	d.Set("drifted_workspace_ids", drifted)
+
+	return nil
+}
+
+func resourceTFETeamAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	old, _ := d.GetChange("team_access_ids")
+	if err := resourceTFETeamAccessPolicyReconcile(tfeClient, d, old.(map[string]interface{})); err != nil {
+		return err
+	}
+
+	return resourceTFETeamAccessPolicyRead(d, meta)
+}
+
+func resourceTFETeamAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	for workspaceID, id := range d.Get("team_access_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Delete team access %s on workspace: %s", id, workspaceID)
+		err := tfeClient.TeamAccess.Remove(ctx, id.(string))
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting team access %s: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceTFETeamAccessPolicyReconcile grants the configured access to every
+// workspace matching the selectors, updates access that drifted and removes
+// access from previously granted workspaces that no longer match.
+func resourceTFETeamAccessPolicyReconcile(client *tfe.Client, d *schema.ResourceData, granted map[string]interface{}) error {
+	teamID := d.Get("team_id").(string)
+	organization := d.Get("organization").(string)
+	access := d.Get("access").(string)
+
+	workspaces, err := listWorkspacesBySelector(
+		client, organization, teamAccessPolicyWorkspaceNames(d), teamAccessPolicyWorkspaceTagNames(d), "")
+	if err != nil {
+		return err
+	}
+
+	// Read the current access of the team on all matching workspaces first,
+	// so nothing is changed when any of them conflicts.
+	current := make(map[string]*tfe.TeamAccess, len(workspaces))
+	var conflicts []string
+	for _, ws := range workspaces {
+		tmAccess, err := readTeamAccessForWorkspace(client, teamID, ws.ID)
+		if err != nil {
+			return err
+		}
+
+		if tmAccess != nil && granted[ws.ID] != tmAccess.ID {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s)", ws.Name, tmAccess.ID))
+		}
+		current[ws.ID] = tmAccess
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf(
+			"team %s already has access to workspaces not managed by this policy: %s; "+
+				"remove that access or import the policy to manage it",
+			teamID, strings.Join(conflicts, ", "))
+	}
+
+	// Keep track of the access granted so far, so it is stored even when an
+	// error occurs halfway.
+	teamAccessIDs := make(map[string]interface{}, len(granted))
+	for workspaceID, id := range granted {
+		teamAccessIDs[workspaceID] = id
+	}
+	defer d.Set("team_access_ids", teamAccessIDs)
+
+	matched := make(map[string]bool, len(workspaces))
+	var result *tfe.TeamAccess
+	for _, ws := range workspaces {
+		tmAccess := current[ws.ID]
+		matched[ws.ID] = true
+
+		var err error
+		switch {
+		case tmAccess == nil:
+			options := tfe.TeamAccessAddOptions{
+				Access:    tfe.Access(tfe.AccessType(access)),
+				Team:      &tfe.Team{ID: teamID},
+				Workspace: ws,
+			}
+			if access == string(tfe.AccessCustom) {
+				options.Runs = tfe.RunsPermission(tfe.RunsPermissionType(d.Get("permissions.0.runs").(string)))
+				options.Variables = tfe.VariablesPermission(tfe.VariablesPermissionType(d.Get("permissions.0.variables").(string)))
+				options.StateVersions = tfe.StateVersionsPermission(tfe.StateVersionsPermissionType(d.Get("permissions.0.state_versions").(string)))
+				options.SentinelMocks = tfe.SentinelMocksPermission(tfe.SentinelMocksPermissionType(d.Get("permissions.0.sentinel_mocks").(string)))
+				options.WorkspaceLocking = tfe.Bool(d.Get("permissions.0.workspace_locking").(bool))
+			}
+
+			log.Printf("[DEBUG] Give team %s %s access to workspace: %s", teamID, access, ws.Name)
+			tmAccess, err = client.TeamAccess.Add(ctx, options)
+			if err != nil {
+				return fmt.Errorf(
+					"Error giving team %s %s access to workspace %s: %v", teamID, access, ws.Name, err)
+			}
+
+		case !teamAccessPolicyMatches(d, tmAccess):
+			options := tfe.TeamAccessUpdateOptions{
+				Access: tfe.Access(tfe.AccessType(access)),
+			}
+			if access == string(tfe.AccessCustom) {
+				options.Runs = tfe.RunsPermission(tfe.RunsPermissionType(d.Get("permissions.0.runs").(string)))
+				options.Variables = tfe.VariablesPermission(tfe.VariablesPermissionType(d.Get("permissions.0.variables").(string)))
+				options.StateVersions = tfe.StateVersionsPermission(tfe.StateVersionsPermissionType(d.Get("permissions.0.state_versions").(string)))
+				options.SentinelMocks = tfe.SentinelMocksPermission(tfe.SentinelMocksPermissionType(d.Get("permissions.0.sentinel_mocks").(string)))
+				options.WorkspaceLocking = tfe.Bool(d.Get("permissions.0.workspace_locking").(bool))
+			}
+
+			id := tmAccess.ID
+			log.Printf("[DEBUG] Update team access: %s", id)
+			tmAccess, err = client.TeamAccess.Update(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("Error updating team access %s: %v", id, err)
+			}
+		}
+
+		teamAccessIDs[ws.ID] = tmAccess.ID
+		result = tmAccess
+	}
+
+	// Remove access from workspaces that no longer match the selectors.
+	for workspaceID, id := range granted {
+		if matched[workspaceID] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Remove team access %s from workspace: %s", id, workspaceID)
+		err := client.TeamAccess.Remove(ctx, id.(string))
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting team access %s: %v", id, err)
+		}
+		delete(teamAccessIDs, workspaceID)
+	}
+
+	// Update permissions, in the case that they were marked to be recomputed.
+	// Custom permissions are kept as configured when no workspace matches.
+	if result != nil {
+		permissions := []map[string]interface{}{{
+			"runs":              result.Runs,
+			"variables":         result.Variables,
+			"state_versions":    result.StateVersions,
+			"sentinel_mocks":    result.SentinelMocks,
+			"workspace_locking": result.WorkspaceLocking,
+		}}
+		if err := d.Set("permissions", permissions); err != nil {
+			return fmt.Errorf("error setting permissions for team access policy: %s", err)
+		}
+	} else if access != string(tfe.AccessCustom) {
+		d.Set("permissions", []map[string]interface{}{})
+	}
+
+	return nil
+}
+
+// reconcileTeamAccessPolicyWorkspaces plans an update whenever the refreshed
+// set of matching workspaces differs from the workspaces holding matching
+// team access, e.g. because a new workspace was tagged.
+func reconcileTeamAccessPolicyWorkspaces(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("access") || d.HasChange("permissions") ||
+		d.HasChange("workspace_names") || d.HasChange("workspace_tag_names") {
+		d.SetNewComputed("workspace_ids")
+		d.SetNewComputed("team_access_ids")
+		d.SetNewComputed("drifted_workspace_ids")
+		return nil
+	}
+
+	workspaceIDs := d.Get("workspace_ids").(*schema.Set)
+	teamAccessIDs := d.Get("team_access_ids").(map[string]interface{})
+
+	inSync := workspaceIDs.Len() == len(teamAccessIDs)
+	for _, id := range workspaceIDs.List() {
+		if _, ok := teamAccessIDs[id.(string)]; !ok {
+			inSync = false
+			break
+		}
+	}
+
+	if !inSync || d.Get("drifted_workspace_ids").(*schema.Set).Len() > 0 {
+		log.Printf("[DEBUG] Team access policy %s is out of sync with the matching workspaces", d.Id())
+		d.SetNewComputed("team_access_ids")
+		d.SetNewComputed("drifted_workspace_ids")
+	}
+
+	return nil
+}
+
+// readTeamAccessForWorkspace returns the access the given team has on the
+// given workspace, or nil if the team has no access.
+func readTeamAccessForWorkspace(client *tfe.Client, teamID, workspaceID string) (*tfe.TeamAccess, error) {
+	options := &tfe.TeamAccessListOptions{
+		WorkspaceID: workspaceID,
+	}
+
+	for {
+		l, err := client.TeamAccess.List(ctx, options)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("Error retrieving team access for workspace %s: %v", workspaceID, err)
+		}
+
+		for _, tmAccess := range l.Items {
+			if tmAccess.Team != nil && tmAccess.Team.ID == teamID {
+				return tmAccess, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.Pagination == nil || l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return nil, nil
+}
+
+// teamAccessPolicyMatches reports whether the given team access grants the
+// configured access level or custom permissions.
+func teamAccessPolicyMatches(d *schema.ResourceData, tmAccess *tfe.TeamAccess) bool {
+	access := d.Get("access").(string)
+	if string(tmAccess.Access) != access {
+		return false
+	}
+
+	if access != string(tfe.AccessCustom) {
+		return true
+	}
+
+	return string(tmAccess.Runs) == d.Get("permissions.0.runs").(string) &&
+		string(tmAccess.Variables) == d.Get("permissions.0.variables").(string) &&
+		string(tmAccess.StateVersions) == d.Get("permissions.0.state_versions").(string) &&
+		string(tmAccess.SentinelMocks) == d.Get("permissions.0.sentinel_mocks").(string) &&
+		tmAccess.WorkspaceLocking == d.Get("permissions.0.workspace_locking").(bool)
+}
+
+func teamAccessPolicyWorkspaceNames(d *schema.ResourceData) []string {
+	var names []string
+	for _, name := range d.Get("workspace_names").([]interface{}) {
+		// ignore empty strings
+		if name == nil {
+			continue
+		}
+		names = append(names, name.(string))
+	}
+	return names
+}
+
+func teamAccessPolicyWorkspaceTagNames(d *schema.ResourceData) []string {
+	var tagNames []string
+	for _, tagName := range d.Get("workspace_tag_names").([]interface{}) {
+		name := tagName.(string)
+		if len(strings.TrimSpace(name)) != 0 {
+			tagNames = append(tagNames, name)
+		}
+	}
+	return tagNames
+}
+
+func resourceTFETeamAccessPolicyImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(*tfe.Client)
+
+	s := strings.SplitN(d.Id(), "/", 2)
+	if len(s) != 2 {
+		return nil, fmt.Errorf(
+			"invalid team access policy import format: %s (expected <ORGANIZATION>/<TEAM ID>)",
+			d.Id(),
+		)
+	}
+	organization, teamID := s[0], s[1]
+
+	// The imported policy takes over all access the team has on the
+	// workspaces of the organization.
+	workspaces, err := listWorkspacesBySelector(tfeClient, organization, []string{"*"}, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	teamAccessIDs := make(map[string]interface{})
+	for _, ws := range workspaces {
+		tmAccess, err := readTeamAccessForWorkspace(tfeClient, teamID, ws.ID)
+		if err != nil {
+			return nil, err
+		}
+		if tmAccess != nil {
+			teamAccessIDs[ws.ID] = tmAccess.ID
+		}
+	}
+
+	d.Set("organization", organization)
+	d.Set("team_id", teamID)
+	d.Set("team_access_ids", teamAccessIDs)
+
+	return []*schema.ResourceData{d}, nil
+}
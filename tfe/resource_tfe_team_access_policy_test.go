@@ -0,0 +1,300 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFETeamAccessPolicy_tags(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamAccessPolicy_tags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessPolicyGranted("tfe_team_access_policy.foobar", 2, tfe.AccessWrite),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "access", "write"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "workspace_ids.#", "2"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "team_access_ids.%", "2"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "permissions.0.runs", "apply"),
+				),
+			},
+			{
+				// Create a new matching workspace outside of Terraform, which
+				// the next refresh should pick up and grant access to.
+				PreConfig: func() {
+					tfeClient := testAccProvider.Meta().(*tfe.Client)
+					_, err := tfeClient.Workspaces.Create(ctx, orgName, tfe.WorkspaceCreateOptions{
+						Name: tfe.String("workspace-test-3"),
+						Tags: []*tfe.Tag{{Name: "app"}},
+					})
+					if err != nil {
+						t.Fatalf("error creating workspace: %v", err)
+					}
+				},
+				Config: testAccTFETeamAccessPolicy_tags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessPolicyGranted("tfe_team_access_policy.foobar", 3, tfe.AccessWrite),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "workspace_ids.#", "3"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "team_access_ids.%", "3"),
+				),
+			},
+			{
+				ResourceName:      "tfe_team_access_policy.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"access",
+					"permissions",
+					"workspace_names",
+					"workspace_tag_names",
+					"workspace_ids",
+					"drifted_workspace_ids",
+				},
+			},
+		},
+	})
+}
+
+func TestAccTFETeamAccessPolicy_customNames(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamAccessPolicy_customNames(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessPolicyGranted("tfe_team_access_policy.foobar", 1, tfe.AccessCustom),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "access", "custom"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "permissions.0.runs", "plan"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "permissions.0.state_versions", "read-outputs"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "team_access_ids.%", "1"),
+				),
+			},
+			{
+				Config: testAccTFETeamAccessPolicy_tags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessPolicyGranted("tfe_team_access_policy.foobar", 2, tfe.AccessWrite),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "access", "write"),
+					resource.TestCheckResourceAttr("tfe_team_access_policy.foobar", "team_access_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamAccessPolicy_existingAccess(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFETeamAccessPolicy_existingAccess(rInt),
+				ExpectError: regexp.MustCompile(`already has access to workspaces not managed by this policy`),
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamAccessPolicyGranted(n string, count int, access tfe.AccessType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		granted := 0
+		for k, id := range rs.Primary.Attributes {
+			if k == "team_access_ids.%" || !strings.HasPrefix(k, "team_access_ids.") {
+				continue
+			}
+
+			tmAccess, err := tfeClient.TeamAccess.Read(ctx, id)
+			if err != nil {
+				return fmt.Errorf("Error reading team access %s: %v", id, err)
+			}
+
+			if tmAccess.Access != access {
+				return fmt.Errorf("Bad access for team access %s: %s", id, tmAccess.Access)
+			}
+
+			granted++
+		}
+
+		if granted != count {
+			return fmt.Errorf("Bad number of team accesses: %d (expected %d)", granted, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamAccessPolicyDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_access_policy" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		for k, id := range rs.Primary.Attributes {
+			if k == "team_access_ids.%" || !strings.HasPrefix(k, "team_access_ids.") {
+				continue
+			}
+
+			_, err := tfeClient.TeamAccess.Read(ctx, id)
+			if err == nil {
+				return fmt.Errorf("Team access %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTFETeamAccessPolicy_tags(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "app1" {
+  name         = "workspace-test-1"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["app"]
+}
+
+resource "tfe_workspace" "app2" {
+  name         = "workspace-test-2"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["app"]
+}
+
+resource "tfe_workspace" "other" {
+  name         = "workspace-other"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_access_policy" "foobar" {
+  access              = "write"
+  team_id             = tfe_team.foobar.id
+  organization        = tfe_organization.foobar.id
+  workspace_tag_names = ["app"]
+
+  depends_on = [tfe_workspace.app1, tfe_workspace.app2, tfe_workspace.other]
+}`, rInt)
+}
+
+func testAccTFETeamAccessPolicy_customNames(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "app1" {
+  name         = "workspace-test-1"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["app"]
+}
+
+resource "tfe_workspace" "app2" {
+  name         = "workspace-test-2"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["app"]
+}
+
+resource "tfe_workspace" "other" {
+  name         = "workspace-other"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_access_policy" "foobar" {
+  permissions {
+    runs              = "plan"
+    variables         = "read"
+    state_versions    = "read-outputs"
+    sentinel_mocks    = "none"
+    workspace_locking = false
+  }
+  team_id         = tfe_team.foobar.id
+  organization    = tfe_organization.foobar.id
+  workspace_names = [tfe_workspace.app1.name]
+
+  depends_on = [tfe_workspace.app1, tfe_workspace.app2, tfe_workspace.other]
+}`, rInt)
+}
+
+func testAccTFETeamAccessPolicy_existingAccess(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "app1" {
+  name         = "workspace-test-1"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["app"]
+}
+
+resource "tfe_team_access" "foobar" {
+  access       = "read"
+  team_id      = tfe_team.foobar.id
+  workspace_id = tfe_workspace.app1.id
+}
+
+resource "tfe_team_access_policy" "foobar" {
+  access              = "write"
+  team_id             = tfe_team.foobar.id
+  organization        = tfe_organization.foobar.id
+  workspace_tag_names = ["app"]
+
+  depends_on = [tfe_team_access.foobar]
+}`, rInt)
+}
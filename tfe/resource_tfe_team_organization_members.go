@@ -0,0 +1,291 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFETeamOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFETeamOrganizationMembersCreate,
+		Read:   resourceTFETeamOrganizationMembersRead,
+		Update: resourceTFETeamOrganizationMembersUpdate,
+		Delete: resourceTFETeamOrganizationMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFETeamOrganizationMembersImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"emails": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+			},
+
+			"organization_membership_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"pending_emails": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the team ID and organization.
+	teamID := d.Get("team_id").(string)
+	organization := d.Get("organization").(string)
+
+	// Create a new options struct.
+	options := tfe.TeamMemberAddOptions{}
+
+	// Make sure every email has an organization membership, inviting the
+	// users that are not yet part of the organization.
+	for _, email := range d.Get("emails").(*schema.Set).List() {
+		membership, err := findOrCreateOrganizationMembership(tfeClient, organization, email.(string))
+		if err != nil {
+			return err
+		}
+		options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, membership.ID)
+	}
+
+	if len(options.OrganizationMembershipIDs) > 0 {
+		log.Printf("[DEBUG] Add organization memberships to team: %s", teamID)
+		err := tfeClient.TeamMembers.Add(ctx, teamID, options)
+		if err != nil {
+			return fmt.Errorf("Error adding organization memberships to team %s: %v", teamID, err)
+		}
+	}
+
+	d.SetId(teamID)
+
+	// The configured emails are authoritative, so remove the members the team
+	// already had that are not configured.
+	log.Printf("[DEBUG] Retrieve organization memberships to remove from team: %s", teamID)
+	memberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving organization memberships to remove from team %s: %v", teamID, err)
+	}
+
+	emails := d.Get("emails").(*schema.Set)
+	removeOptions := tfe.TeamMemberRemoveOptions{}
+	for _, membership := range memberships {
+		if !emails.Contains(membership.Email) {
+			removeOptions.OrganizationMembershipIDs = append(removeOptions.OrganizationMembershipIDs, membership.ID)
+		}
+	}
+
+	if len(removeOptions.OrganizationMembershipIDs) > 0 {
+		log.Printf("[DEBUG] Remove organization memberships from team: %s", teamID)
+		err := tfeClient.TeamMembers.Remove(ctx, teamID, removeOptions)
+		if err != nil {
+			return fmt.Errorf("Error removing organization memberships from team %s: %v", teamID, err)
+		}
+	}
+
+	return resourceTFETeamOrganizationMembersRead(d, meta)
+}
+
+func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
+	memberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Team %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading organization memberships from team %s: %v", d.Id(), err)
+	}
+
+	var emails []interface{}
+	var membershipIDs []interface{}
+	var pendingEmails []interface{}
+	for _, membership := range memberships {
+		emails = append(emails, membership.Email)
+		membershipIDs = append(membershipIDs, membership.ID)
+
+		if membership.Status == tfe.OrganizationMembershipInvited {
+			pendingEmails = append(pendingEmails, membership.Email)
+		}
+	}
+
+	d.Set("team_id", d.Id())
+	d.Set("emails", emails)
+	d.Set("organization_membership_ids", membershipIDs)
+	d.Set("pending_emails", pendingEmails)
+
+	return nil
+}
+
+func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	organization := d.Get("organization").(string)
+
+	if d.HasChange("emails") {
+		old, new := d.GetChange("emails")
+		oldEmails := old.(*schema.Set).Difference(new.(*schema.Set))
+		newEmails := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		// First add the new members.
+		if newEmails.Len() > 0 {
+			// Create a new options struct.
+			options := tfe.TeamMemberAddOptions{}
+
+			for _, email := range newEmails.List() {
+				membership, err := findOrCreateOrganizationMembership(tfeClient, organization, email.(string))
+				if err != nil {
+					return err
+				}
+				options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, membership.ID)
+			}
+
+			log.Printf("[DEBUG] Add organization memberships to team: %s", d.Id())
+			err := tfeClient.TeamMembers.Add(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error adding organization memberships to team %s: %v", d.Id(), err)
+			}
+		}
+
+		// Then remove all the old members.
+		if oldEmails.Len() > 0 {
+			log.Printf("[DEBUG] Retrieve organization memberships to remove from team: %s", d.Id())
+			memberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, d.Id())
+			if err != nil {
+				return fmt.Errorf("Error retrieving organization memberships to remove from team %s: %v", d.Id(), err)
+			}
+
+			// Create a new options struct.
+			options := tfe.TeamMemberRemoveOptions{}
+
+			for _, membership := range memberships {
+				if oldEmails.Contains(membership.Email) {
+					options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, membership.ID)
+				}
+			}
+
+			if len(options.OrganizationMembershipIDs) > 0 {
+				log.Printf("[DEBUG] Remove organization memberships from team: %s", d.Id())
+				err := tfeClient.TeamMembers.Remove(ctx, d.Id(), options)
+				if err != nil {
+					return fmt.Errorf("Error removing organization memberships from team %s: %v", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return resourceTFETeamOrganizationMembersRead(d, meta)
+}
+
+func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Retrieve organization memberships to remove from team: %s", d.Id())
+	memberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving organization memberships to remove from team %s: %v", d.Id(), err)
+	}
+
+	if len(memberships) == 0 {
+		return nil
+	}
+
+	// Create a new options struct.
+	options := tfe.TeamMemberRemoveOptions{}
+
+	// Add all the memberships that need to be removed.
+	for _, membership := range memberships {
+		options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, membership.ID)
+	}
+
+	log.Printf("[DEBUG] Remove organization memberships from team: %s", d.Id())
+	err = tfeClient.TeamMembers.Remove(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error removing organization memberships from team %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFETeamOrganizationMembersImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), "/", 2)
+	if len(s) != 2 {
+		return nil, fmt.Errorf(
+			"invalid team organization members import format: %s (expected <ORGANIZATION>/<TEAM ID>)",
+			d.Id(),
+		)
+	}
+
+	// Set the fields that are part of the import ID.
+	d.Set("organization", s[0])
+	d.Set("team_id", s[1])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findOrCreateOrganizationMembership returns the organization membership
+// belonging to the given email, inviting the user to the organization when
+// no membership exists yet.
+func findOrCreateOrganizationMembership(client *tfe.Client, organization, email string) (*tfe.OrganizationMembership, error) {
+	options := &tfe.OrganizationMembershipListOptions{
+		Emails: []string{email},
+	}
+
+	log.Printf("[DEBUG] Find membership %s for organization: %s", email, organization)
+	l, err := client.OrganizationMemberships.List(ctx, organization, options)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error retrieving membership %s for organization %s: %v", email, organization, err)
+	}
+
+	for _, membership := range l.Items {
+		if strings.EqualFold(membership.Email, email) {
+			return membership, nil
+		}
+	}
+
+	log.Printf("[DEBUG] Create membership %s for organization: %s", email, organization)
+	membership, err := client.OrganizationMemberships.Create(ctx, organization, tfe.OrganizationMembershipCreateOptions{
+		Email: tfe.String(email),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error creating membership %s for organization %s: %v", email, organization, err)
+	}
+
+	return membership, nil
+}
@@ -0,0 +1,298 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFETeamOrganizationMembers_basic(t *testing.T) {
+	memberships := []*tfe.OrganizationMembership{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamOrganizationMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamOrganizationMembers_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamOrganizationMembersExists(
+						"tfe_team_organization_members.foobar", &memberships),
+					testAccCheckTFETeamOrganizationMembersEmails(
+						&memberships, []string{"example@hashicorp.com", "example2@hashicorp.com"}),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "emails.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "organization_membership_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "pending_emails.#", "2"),
+				),
+			},
+			{
+				Config: testAccTFETeamOrganizationMembers_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamOrganizationMembersExists(
+						"tfe_team_organization_members.foobar", &memberships),
+					testAccCheckTFETeamOrganizationMembersEmails(
+						&memberships, []string{"example2@hashicorp.com", "example3@hashicorp.com"}),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "emails.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "pending_emails.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamOrganizationMembers_existingMembership(t *testing.T) {
+	memberships := []*tfe.OrganizationMembership{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamOrganizationMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamOrganizationMembers_existingMembership(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamOrganizationMembersExists(
+						"tfe_team_organization_members.foobar", &memberships),
+					testAccCheckTFETeamOrganizationMembersEmails(
+						&memberships, []string{"example@hashicorp.com"}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_team_organization_members.foobar", "organization_membership_ids.*",
+						"tfe_organization_membership.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamOrganizationMembers_existingTeamMembers(t *testing.T) {
+	team := &tfe.Team{}
+	memberships := []*tfe.OrganizationMembership{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamOrganizationMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamOrganizationMembers_team(rInt),
+				Check:  testAccCheckTFETeamExists("tfe_team.foobar", team),
+			},
+			{
+				// Add a member outside of Terraform, which has to be removed
+				// when the authoritative resource is created.
+				PreConfig: func() {
+					tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+					membership, err := findOrCreateOrganizationMembership(tfeClient, orgName, "existing@hashicorp.com")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = tfeClient.TeamMembers.Add(ctx, team.ID, tfe.TeamMemberAddOptions{
+						OrganizationMembershipIDs: []string{membership.ID},
+					})
+					if err != nil {
+						t.Fatalf("Error adding existing member to team %s: %v", team.ID, err)
+					}
+				},
+				Config: testAccTFETeamOrganizationMembers_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamOrganizationMembersExists(
+						"tfe_team_organization_members.foobar", &memberships),
+					testAccCheckTFETeamOrganizationMembersEmails(
+						&memberships, []string{"example@hashicorp.com", "example2@hashicorp.com"}),
+					resource.TestCheckResourceAttr(
+						"tfe_team_organization_members.foobar", "emails.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamOrganizationMembers_import(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamOrganizationMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamOrganizationMembers_basic(rInt),
+			},
+
+			{
+				ResourceName:        "tfe_team_organization_members.foobar",
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("tst-terraform-%d/", rInt),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamOrganizationMembersExists(
+	n string, memberships *[]*tfe.OrganizationMembership) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		m, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(m) == 0 {
+			return fmt.Errorf("Team organization memberships not found")
+		}
+
+		*memberships = m
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamOrganizationMembersEmails(
+	memberships *[]*tfe.OrganizationMembership, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(*memberships) != len(expected) {
+			return fmt.Errorf("Bad number of memberships: %d (expected %d)", len(*memberships), len(expected))
+		}
+
+		found := map[string]bool{}
+		for _, membership := range *memberships {
+			found[membership.Email] = true
+		}
+
+		for _, email := range expected {
+			if !found[email] {
+				return fmt.Errorf("Membership for %s not found", email)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamOrganizationMembersDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_organization_members" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		memberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, rs.Primary.ID)
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return err
+		}
+
+		if len(memberships) != 0 {
+			return fmt.Errorf("Team organization memberships still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccTFETeamOrganizationMembers_team(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}`, rInt)
+}
+
+func testAccTFETeamOrganizationMembers_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_organization_members" "foobar" {
+  team_id      = tfe_team.foobar.id
+  organization = tfe_organization.foobar.id
+  emails       = ["example@hashicorp.com", "example2@hashicorp.com"]
+}`, rInt)
+}
+
+func testAccTFETeamOrganizationMembers_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_organization_members" "foobar" {
+  team_id      = tfe_team.foobar.id
+  organization = tfe_organization.foobar.id
+  emails       = ["example2@hashicorp.com", "example3@hashicorp.com"]
+}`, rInt)
+}
+
+func testAccTFETeamOrganizationMembers_existingMembership(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_organization_membership" "foobar" {
+  organization = tfe_organization.foobar.id
+  email        = "example@hashicorp.com"
+}
+
+resource "tfe_team_organization_members" "foobar" {
+  team_id      = tfe_team.foobar.id
+  organization = tfe_organization.foobar.id
+  emails       = [tfe_organization_membership.foobar.email]
+}`, rInt)
+}
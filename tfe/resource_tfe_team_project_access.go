@@ -0,0 +1,407 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// teamProjectAccessPermissions lists the attributes of a team project access
+// permissions block.
+var teamProjectAccessPermissions = []string{
+	"permissions.0.project_settings",
+	"permissions.0.project_teams",
+	"permissions.0.workspace_runs",
+	"permissions.0.workspace_variables",
+	"permissions.0.workspace_state_versions",
+	"permissions.0.workspace_sentinel_mocks",
+	"permissions.0.workspace_create",
+	"permissions.0.workspace_locking",
+	"permissions.0.workspace_move",
+	"permissions.0.workspace_delete",
+	"permissions.0.workspace_run_tasks",
+}
+
+func resourceTFETeamProjectAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFETeamProjectAccessCreate,
+		Read:   resourceTFETeamProjectAccessRead,
+		Update: resourceTFETeamProjectAccessUpdate,
+		Delete: resourceTFETeamProjectAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTFETeamProjectAccessImporter,
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return setCustomOrComputedAccessPermissions(
+				d, string(tfe.TeamProjectAccessCustom), teamProjectAccessPermissions)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"access", "permissions"},
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.TeamProjectAccessAdmin),
+						string(tfe.TeamProjectAccessMaintain),
+						string(tfe.TeamProjectAccessWrite),
+						string(tfe.TeamProjectAccessRead),
+					},
+					false,
+				),
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_settings": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.ProjectSettingsPermissionRead),
+									string(tfe.ProjectSettingsPermissionUpdate),
+									string(tfe.ProjectSettingsPermissionDelete),
+								},
+								false,
+							),
+						},
+
+						"project_teams": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.ProjectTeamsPermissionNone),
+									string(tfe.ProjectTeamsPermissionRead),
+									string(tfe.ProjectTeamsPermissionManage),
+								},
+								false,
+							),
+						},
+
+						"workspace_runs": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.WorkspaceRunsPermissionRead),
+									string(tfe.WorkspaceRunsPermissionPlan),
+									string(tfe.WorkspaceRunsPermissionApply),
+								},
+								false,
+							),
+						},
+
+						"workspace_variables": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.WorkspaceVariablesPermissionNone),
+									string(tfe.WorkspaceVariablesPermissionRead),
+									string(tfe.WorkspaceVariablesPermissionWrite),
+								},
+								false,
+							),
+						},
+
+						"workspace_state_versions": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.WorkspaceStateVersionsPermissionNone),
+									string(tfe.WorkspaceStateVersionsPermissionReadOutputs),
+									string(tfe.WorkspaceStateVersionsPermissionRead),
+									string(tfe.WorkspaceStateVersionsPermissionWrite),
+								},
+								false,
+							),
+						},
+
+						"workspace_sentinel_mocks": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.WorkspaceSentinelMocksPermissionNone),
+									string(tfe.WorkspaceSentinelMocksPermissionRead),
+								},
+								false,
+							),
+						},
+
+						"workspace_create": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_locking": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_move": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_delete": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_run_tasks": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFETeamProjectAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Get the access level.
+	access := d.Get("access").(string)
+
+	// Get the project.
+	projectID := d.Get("project_id").(string)
+	project, err := tfeClient.Projects.Read(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving project %s: %v", projectID, err)
+	}
+
+	// Get the team.
+	teamID := d.Get("team_id").(string)
+	tm, err := tfeClient.Teams.Read(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving team %s: %v", teamID, err)
+	}
+
+	// Create a new options struct.
+	options := tfe.TeamProjectAccessAddOptions{
+		Access:  tfe.TeamProjectAccessType(access),
+		Team:    tm,
+		Project: project,
+	}
+
+	// Custom permissions can only be given together with custom access.
+	if access == string(tfe.TeamProjectAccessCustom) {
+		options.ProjectAccess, options.WorkspaceAccess = teamProjectAccessPermissionsOptions(d)
+	}
+
+	log.Printf("[DEBUG] Give team %s %s access to project: %s", tm.Name, access, project.Name)
+	tmAccess, err := tfeClient.TeamProjectAccess.Add(ctx, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error giving team %s %s access to project %s: %v", tm.Name, access, project.Name, err)
+	}
+
+	d.SetId(tmAccess.ID)
+
+	return resourceTFETeamProjectAccessRead(d, meta)
+}
+
+func resourceTFETeamProjectAccessRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read configuration of team project access: %s", d.Id())
+	tmAccess, err := tfeClient.TeamProjectAccess.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Team project access %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of team project access %s: %v", d.Id(), err)
+	}
+
+	// Update config.
+	d.Set("access", string(tmAccess.Access))
+	if err := d.Set("permissions", flattenTeamProjectAccessPermissions(tmAccess)); err != nil {
+		return fmt.Errorf("error setting permissions for team project access %s: %s", d.Id(), err)
+	}
+
+	if tmAccess.Team != nil {
+		d.Set("team_id", tmAccess.Team.ID)
+	} else {
+		d.Set("team_id", "")
+	}
+
+	if tmAccess.Project != nil {
+		d.Set("project_id", tmAccess.Project.ID)
+	} else {
+		d.Set("project_id", "")
+	}
+
+	return nil
+}
+
+func resourceTFETeamProjectAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Set access level.
+	access := tfe.TeamProjectAccessType(d.Get("access").(string))
+
+	// Create a new options struct.
+	options := tfe.TeamProjectAccessUpdateOptions{
+		Access: &access,
+	}
+
+	// Custom permissions can only be given together with custom access.
+	if access == tfe.TeamProjectAccessCustom {
+		options.ProjectAccess, options.WorkspaceAccess = teamProjectAccessPermissionsOptions(d)
+	}
+
+	log.Printf("[DEBUG] Update team project access: %s", d.Id())
+	tmAccess, err := tfeClient.TeamProjectAccess.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error updating team project access %s: %v", d.Id(), err)
+	}
+
+	// Update permissions, in the case that they were marked to be recomputed.
+	if err := d.Set("permissions", flattenTeamProjectAccessPermissions(tmAccess)); err != nil {
+		return fmt.Errorf("error setting permissions for team project access %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFETeamProjectAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Delete team project access: %s", d.Id())
+	err := tfeClient.TeamProjectAccess.Remove(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting team project access %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFETeamProjectAccessImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(*tfe.Client)
+
+	s := strings.SplitN(d.Id(), "/", 3)
+	if len(s) != 3 {
+		return nil, fmt.Errorf(
+			"invalid team project access import format: %s (expected <ORGANIZATION>/<PROJECT NAME>/<TEAM PROJECT ACCESS ID>)",
+			d.Id(),
+		)
+	}
+
+	// Set the fields that are part of the import ID.
+	project, err := fetchProjectByName(tfeClient, s[0], s[1])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving project %s from organization %s: %v", s[1], s[0], err)
+	}
+	d.Set("project_id", project.ID)
+	d.SetId(s[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// teamProjectAccessPermissionsOptions builds the project and workspace
+// permission options from the configured permissions block.
+func teamProjectAccessPermissionsOptions(d *schema.ResourceData) (
+	*tfe.TeamProjectAccessProjectPermissionsOptions, *tfe.TeamProjectAccessWorkspacePermissionsOptions) {
+	projectAccess := &tfe.TeamProjectAccessProjectPermissionsOptions{}
+	workspaceAccess := &tfe.TeamProjectAccessWorkspacePermissionsOptions{}
+
+	if v, ok := d.GetOk("permissions.0.project_settings"); ok {
+		settings := tfe.ProjectSettingsPermissionType(v.(string))
+		projectAccess.Settings = &settings
+	}
+
+	if v, ok := d.GetOk("permissions.0.project_teams"); ok {
+		teams := tfe.ProjectTeamsPermissionType(v.(string))
+		projectAccess.Teams = &teams
+	}
+
+	if v, ok := d.GetOk("permissions.0.workspace_runs"); ok {
+		runs := tfe.WorkspaceRunsPermissionType(v.(string))
+		workspaceAccess.Runs = &runs
+	}
+
+	if v, ok := d.GetOk("permissions.0.workspace_variables"); ok {
+		variables := tfe.WorkspaceVariablesPermissionType(v.(string))
+		workspaceAccess.Variables = &variables
+	}
+
+	if v, ok := d.GetOk("permissions.0.workspace_state_versions"); ok {
+		stateVersions := tfe.WorkspaceStateVersionsPermissionType(v.(string))
+		workspaceAccess.StateVersions = &stateVersions
+	}
+
+	if v, ok := d.GetOk("permissions.0.workspace_sentinel_mocks"); ok {
+		sentinelMocks := tfe.WorkspaceSentinelMocksPermissionType(v.(string))
+		workspaceAccess.SentinelMocks = &sentinelMocks
+	}
+
+	workspaceAccess.Create = tfe.Bool(d.Get("permissions.0.workspace_create").(bool))
+	workspaceAccess.Locking = tfe.Bool(d.Get("permissions.0.workspace_locking").(bool))
+	workspaceAccess.Move = tfe.Bool(d.Get("permissions.0.workspace_move").(bool))
+	workspaceAccess.Delete = tfe.Bool(d.Get("permissions.0.workspace_delete").(bool))
+	workspaceAccess.RunTasks = tfe.Bool(d.Get("permissions.0.workspace_run_tasks").(bool))
+
+	return projectAccess, workspaceAccess
+}
+
+// flattenTeamProjectAccessPermissions returns the permissions block of a team
+// project access as it is stored in the state.
+func flattenTeamProjectAccessPermissions(tmAccess *tfe.TeamProjectAccess) []map[string]interface{} {
+	permissions := map[string]interface{}{}
+
+	if tmAccess.ProjectAccess != nil {
+		permissions["project_settings"] = string(tmAccess.ProjectAccess.ProjectSettingsPermission)
+		permissions["project_teams"] = string(tmAccess.ProjectAccess.ProjectTeamsPermission)
+	}
+
+	if tmAccess.WorkspaceAccess != nil {
+		permissions["workspace_runs"] = string(tmAccess.WorkspaceAccess.WorkspaceRunsPermission)
+		permissions["workspace_variables"] = string(tmAccess.WorkspaceAccess.WorkspaceVariablesPermission)
+		permissions["workspace_state_versions"] = string(tmAccess.WorkspaceAccess.WorkspaceStateVersionsPermission)
+		permissions["workspace_sentinel_mocks"] = string(tmAccess.WorkspaceAccess.WorkspaceSentinelMocksPermission)
+		permissions["workspace_create"] = tmAccess.WorkspaceAccess.WorkspaceCreatePermission
+		permissions["workspace_locking"] = tmAccess.WorkspaceAccess.WorkspaceLockingPermission
+		permissions["workspace_move"] = tmAccess.WorkspaceAccess.WorkspaceMovePermission
+		permissions["workspace_delete"] = tmAccess.WorkspaceAccess.WorkspaceDeletePermission
+		permissions["workspace_run_tasks"] = tmAccess.WorkspaceAccess.WorkspaceRunTasksPermission
+	}
+
+	return []map[string]interface{}{permissions}
+}
@@ -0,0 +1,241 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFETeamProjectAccess_basic(t *testing.T) {
+	tmAccess := &tfe.TeamProjectAccess{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamProjectAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamProjectAccess_access(rInt, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess, tfe.TeamProjectAccessRead),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "access", "read"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_team_project_access.foobar", "project_id", "tfe_project.foobar", "id"),
+				),
+			},
+
+			{
+				Config: testAccTFETeamProjectAccess_access(rInt, "maintain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess, tfe.TeamProjectAccessMaintain),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "access", "maintain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamProjectAccess_updateToCustom(t *testing.T) {
+	tmAccess := &tfe.TeamProjectAccess{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamProjectAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamProjectAccess_access(rInt, "write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess, tfe.TeamProjectAccessWrite),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "access", "write"),
+				),
+			},
+
+			{
+				Config: testAccTFETeamProjectAccess_custom(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess, tfe.TeamProjectAccessCustom),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "access", "custom"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.project_settings", "update"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.project_teams", "read"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_runs", "plan"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_variables", "read"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_state_versions", "read-outputs"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_sentinel_mocks", "none"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_create", "true"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_locking", "false"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_move", "false"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_delete", "false"),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "permissions.0.workspace_run_tasks", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFETeamProjectAccess_access(rInt, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess, tfe.TeamProjectAccessAdmin),
+					resource.TestCheckResourceAttr("tfe_team_project_access.foobar", "access", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamProjectAccess_import(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamProjectAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamProjectAccess_access(rInt, "write"),
+			},
+
+			{
+				ResourceName:        "tfe_team_project_access.foobar",
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("tst-terraform-%d/project-test/", rInt),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamProjectAccessExists(
+	n string, tmAccess *tfe.TeamProjectAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		ta, err := tfeClient.TeamProjectAccess.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if ta == nil {
+			return fmt.Errorf("TeamProjectAccess not found")
+		}
+
+		*tmAccess = *ta
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamProjectAccessAttributesAccessIs(tmAccess *tfe.TeamProjectAccess, access tfe.TeamProjectAccessType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if tmAccess.Access != access {
+			return fmt.Errorf("Bad access: %s", tmAccess.Access)
+		}
+		return nil
+	}
+}
+
+func testAccCheckTFETeamProjectAccessDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_project_access" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.TeamProjectAccess.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Team project access %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFETeamProjectAccess_access(rInt int, access string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_project_access" "foobar" {
+  access     = "%s"
+  team_id    = tfe_team.foobar.id
+  project_id = tfe_project.foobar.id
+}`, rInt, access)
+}
+
+func testAccTFETeamProjectAccess_custom(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_project_access" "foobar" {
+  team_id    = tfe_team.foobar.id
+  project_id = tfe_project.foobar.id
+
+  permissions {
+    project_settings         = "update"
+    project_teams            = "read"
+    workspace_runs           = "plan"
+    workspace_variables      = "read"
+    workspace_state_versions = "read-outputs"
+    workspace_sentinel_mocks = "none"
+    workspace_create         = true
+    workspace_locking        = false
+    workspace_move           = false
+    workspace_delete         = false
+    workspace_run_tasks      = true
+  }
+}`, rInt)
+}
@@ -1,10 +1,20 @@
 package tfe
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,6 +29,8 @@ func resourceTFETerraformVersion() *schema.Resource {
 			State: resourceTFETerraformVersionImporter,
 		},
 
+		CustomizeDiff: resourceTFETerraformVersionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeString,
@@ -29,8 +41,31 @@ func resourceTFETerraformVersion() *schema.Resource {
 				Required: true,
 			},
 			"sha": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source_file"},
+				AtLeastOneOf:  []string{"sha", "source_file"},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"sha"},
+				AtLeastOneOf:  []string{"sha", "source_file"},
+			},
+			"shasums_file": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"shasums_signature_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"shasums_file", "gpg_public_key"},
+			},
+			"gpg_public_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"shasums_signature_file"},
 			},
 			"official": {
 				Type:     schema.TypeBool,
@@ -169,3 +204,128 @@ func resourceTFETerraformVersionImporter(d *schema.ResourceData, meta interface{
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceTFETerraformVersionCustomizeDiff computes the sha of a local archive
+// and verifies it against a SHA256SUMS manifest, so a wrong checksum is caught
+// at plan time instead of breaking every run on the version.
+func resourceTFETerraformVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sha := d.Get("sha").(string)
+
+	if sourceFile, ok := d.GetOk("source_file"); ok {
+		fileSha, err := fileSha256(sourceFile.(string))
+		if err != nil {
+			return fmt.Errorf("Error computing sha of %s: %v", sourceFile, err)
+		}
+
+		if fileSha != sha {
+			if err := d.SetNew("sha", fileSha); err != nil {
+				return err
+			}
+		}
+		sha = fileSha
+	}
+
+	shasumsFile, ok := d.GetOk("shasums_file")
+	if !ok {
+		return nil
+	}
+
+	shasums, err := os.ReadFile(shasumsFile.(string))
+	if err != nil {
+		return fmt.Errorf("Error reading shasums file %s: %v", shasumsFile, err)
+	}
+
+	if signatureFile, ok := d.GetOk("shasums_signature_file"); ok {
+		signature, err := os.ReadFile(signatureFile.(string))
+		if err != nil {
+			return fmt.Errorf("Error reading shasums signature file %s: %v", signatureFile, err)
+		}
+
+		err = verifyShasumsSignature(shasums, signature, d.Get("gpg_public_key").(string))
+		if err != nil {
+			return fmt.Errorf("Error verifying signature of shasums file %s: %v", shasumsFile, err)
+		}
+	}
+
+	// The archive is listed in the manifest by its file name, which is taken
+	// from the source file if there is one and from the URL otherwise.
+	var archive string
+	if sourceFile, ok := d.GetOk("source_file"); ok {
+		archive = filepath.Base(sourceFile.(string))
+	} else {
+		archive = path.Base(d.Get("url").(string))
+	}
+
+	// The sha is only unknown when it is configured by interpolation, in which
+	// case it can't be verified until it's known.
+	if sha == "" {
+		return nil
+	}
+
+	if err := verifyShasum(shasums, archive, sha); err != nil {
+		return fmt.Errorf("Error verifying sha against shasums file %s: %v", shasumsFile, err)
+	}
+
+	return nil
+}
+
+// fileSha256 returns the hex encoded SHA-256 checksum of the given file.
+func fileSha256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyShasum checks that the SHA256SUMS manifest lists the given archive
+// with the given sha.
+func verifyShasum(shasums []byte, archive, sha string) error {
+	scanner := bufio.NewScanner(bytes.NewReader(shasums))
+	for scanner.Scan() {
+		// Each line has the format "<sha>  <file name>", where the file name
+		// might be prefixed with a '*' to indicate binary mode.
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != archive {
+			continue
+		}
+
+		if !strings.EqualFold(fields[0], sha) {
+			return fmt.Errorf("sha %s of %s does not match %s", sha, archive, fields[0])
+		}
+
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%s is not listed", archive)
+}
+
+// verifyShasumsSignature checks that the detached signature, either binary or
+// ASCII armored, of the SHA256SUMS manifest was made by the given ASCII
+// armored public key.
+func verifyShasumsSignature(shasums, signature []byte, publicKey string) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(publicKey))
+	if err != nil {
+		return fmt.Errorf("invalid GPG public key: %v", err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(
+			keyring, bytes.NewReader(shasums), bytes.NewReader(signature), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(
+			keyring, bytes.NewReader(shasums), bytes.NewReader(signature), nil)
+	}
+
+	return err
+}
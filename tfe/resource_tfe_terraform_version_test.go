@@ -1,14 +1,20 @@
 package tfe
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -112,6 +118,132 @@ func TestAccTFETerraformVersion_full(t *testing.T) {
 	})
 }
 
+func TestAccTFETerraformVersion_sourceFile(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	tfVersion := &tfe.AdminTerraformVersion{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	version := genVersion(rInt)
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, fmt.Sprintf("terraform_%s_linux_amd64.zip", version))
+	if err := os.WriteFile(archive, []byte("data"), 0644); err != nil {
+		t.Fatalf("error writing archive: %v", err)
+	}
+	sha := fmt.Sprintf("%x", sha256.Sum256([]byte("data")))
+
+	shasums := filepath.Join(dir, "SHA256SUMS")
+	content := fmt.Sprintf("%s  %s\n", sha, filepath.Base(archive))
+	if err := os.WriteFile(shasums, []byte(content), 0644); err != nil {
+		t.Fatalf("error writing shasums file: %v", err)
+	}
+
+	badShasums := filepath.Join(dir, "SHA256SUMS.bad")
+	content = fmt.Sprintf("%s  %s\n", genSha(t, "secret", "data"), filepath.Base(archive))
+	if err := os.WriteFile(badShasums, []byte(content), 0644); err != nil {
+		t.Fatalf("error writing shasums file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETerraformVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFETerraformVersion_sourceFile(version, archive, badShasums),
+				ExpectError: regexp.MustCompile(`does not match`),
+			},
+			{
+				Config: testAccTFETerraformVersion_sourceFile(version, archive, shasums),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETerraformVersionExists("tfe_terraform_version.foobar", tfVersion),
+					testAccCheckTFETerraformVersionAttributesBasic(tfVersion, version, sha),
+					resource.TestCheckResourceAttr(
+						"tfe_terraform_version.foobar", "sha", sha),
+				),
+			},
+		},
+	})
+}
+
+func TestVerifyShasum(t *testing.T) {
+	sha := fmt.Sprintf("%x", sha256.Sum256([]byte("data")))
+	shasums := []byte(fmt.Sprintf(
+		"%s  terraform_1.1.2_darwin_amd64.zip\n%s *terraform_1.1.2_linux_amd64.zip\n",
+		genSha(t, "secret", "data"), sha,
+	))
+
+	cases := map[string]struct {
+		archive string
+		sha     string
+		err     bool
+	}{
+		"matching sha": {
+			archive: "terraform_1.1.2_linux_amd64.zip",
+			sha:     sha,
+		},
+		"mismatching sha": {
+			archive: "terraform_1.1.2_darwin_amd64.zip",
+			sha:     sha,
+			err:     true,
+		},
+		"unlisted archive": {
+			archive: "terraform_1.1.2_windows_amd64.zip",
+			sha:     sha,
+			err:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		err := verifyShasum(shasums, tc.archive, tc.sha)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error %t, got: %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestVerifyShasumsSignature(t *testing.T) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("error generating GPG key: %v", err)
+	}
+
+	var publicKey bytes.Buffer
+	w, err := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("error encoding GPG key: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("error serializing GPG key: %v", err)
+	}
+	w.Close()
+
+	shasums := []byte("abc  terraform_1.1.2_linux_amd64.zip\n")
+
+	var signature bytes.Buffer
+	if err := openpgp.DetachSign(&signature, entity, bytes.NewReader(shasums), nil); err != nil {
+		t.Fatalf("error signing shasums: %v", err)
+	}
+
+	var armoredSignature bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&armoredSignature, entity, bytes.NewReader(shasums), nil); err != nil {
+		t.Fatalf("error signing shasums: %v", err)
+	}
+
+	if err := verifyShasumsSignature(shasums, signature.Bytes(), publicKey.String()); err != nil {
+		t.Fatalf("expected valid signature, got: %v", err)
+	}
+
+	if err := verifyShasumsSignature(shasums, armoredSignature.Bytes(), publicKey.String()); err != nil {
+		t.Fatalf("expected valid armored signature, got: %v", err)
+	}
+
+	tampered := []byte("def  terraform_1.1.2_linux_amd64.zip\n")
+	if err := verifyShasumsSignature(tampered, signature.Bytes(), publicKey.String()); err == nil {
+		t.Fatalf("expected invalid signature for tampered shasums")
+	}
+}
+
 func testAccCheckTFETerraformVersionDestroy(s *terraform.State) error {
 	tfeClient := testAccProvider.Meta().(*tfe.Client)
 
@@ -240,6 +372,16 @@ resource "tfe_terraform_version" "foobar" {
 }`, version, sha)
 }
 
+func testAccTFETerraformVersion_sourceFile(version, sourceFile, shasumsFile string) string {
+	return fmt.Sprintf(`
+resource "tfe_terraform_version" "foobar" {
+  version      = "%s"
+  url          = "https://www.hashicorp.com"
+  source_file  = "%s"
+  shasums_file = "%s"
+}`, version, sourceFile, shasumsFile)
+}
+
 // Helper functions
 func genSha(t *testing.T, secret, data string) string {
 	h := hmac.New(sha256.New, []byte(secret))
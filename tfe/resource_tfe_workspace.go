@@ -6,8 +6,12 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -39,11 +43,21 @@ func resourceTFEWorkspace() *schema.Resource {
 				return err
 			}
 
+			err = validateTerraformVersion(c, d, meta)
+			if err != nil {
+				return err
+			}
+
 			err = validateRemoteState(c, d, meta)
 			if err != nil {
 				return err
 			}
 
+			err = validateVCSTriggers(c, d, meta)
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 
@@ -64,6 +78,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Optional: true,
 			},
 
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"agent_pool_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -76,6 +96,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Default:  true,
 			},
 
+			"assessments_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"auto_apply": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -161,10 +187,18 @@ func resourceTFEWorkspace() *schema.Resource {
 			},
 
 			"trigger_prefixes": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"trigger_patterns"},
+			},
+
+			"trigger_patterns": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"trigger_prefixes"},
 			},
 
 			"working_directory": {
@@ -197,8 +231,21 @@ func resourceTFEWorkspace() *schema.Resource {
 						},
 
 						"oauth_token_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
+						},
+
+						"github_app_installation_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"vcs_repo.0.oauth_token_id", "vcs_repo.0.github_app_installation_id"},
+						},
+
+						"tags_regex": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"trigger_prefixes", "trigger_patterns"},
 						},
 					},
 				},
@@ -218,6 +265,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	options := tfe.WorkspaceCreateOptions{
 		Name:                       tfe.String(name),
 		AllowDestroyPlan:           tfe.Bool(d.Get("allow_destroy_plan").(bool)),
+		AssessmentsEnabled:         tfe.Bool(d.Get("assessments_enabled").(bool)),
 		AutoApply:                  tfe.Bool(d.Get("auto_apply").(bool)),
 		Description:                tfe.String(d.Get("description").(string)),
 		FileTriggersEnabled:        tfe.Bool(d.Get("file_triggers_enabled").(bool)),
@@ -237,6 +285,12 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		options.AgentPoolID = tfe.String(v.(string))
 	}
 
+	// Only set the project if one is configured, otherwise the workspace is
+	// created in the organization's default project.
+	if v, ok := d.GetOk("project_id"); ok && v.(string) != "" {
+		options.Project = &tfe.Project{ID: v.(string)}
+	}
+
 	if v, ok := d.GetOk("execution_mode"); ok {
 		options.ExecutionMode = tfe.String(v.(string))
 	}
@@ -258,6 +312,14 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if tps, ok := d.GetOk("trigger_patterns"); ok {
+		for _, tp := range tps.([]interface{}) {
+			if t, ok := tp.(string); ok {
+				options.TriggerPatterns = append(options.TriggerPatterns, t)
+			}
+		}
+	}
+
 	// Get and assert the VCS repo configuration block.
 	if v, ok := d.GetOk("vcs_repo"); ok {
 		vcsRepo := v.([]interface{})[0].(map[string]interface{})
@@ -265,13 +327,26 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		options.VCSRepo = &tfe.VCSRepoOptions{
 			Identifier:        tfe.String(vcsRepo["identifier"].(string)),
 			IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
-			OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+		}
+
+		// Set either the OAuth token or the GitHub App installation, depending
+		// on which one is configured.
+		if tokenID, ok := vcsRepo["oauth_token_id"].(string); ok && tokenID != "" {
+			options.VCSRepo.OAuthTokenID = tfe.String(tokenID)
+		}
+		if ghaInstallationID, ok := vcsRepo["github_app_installation_id"].(string); ok && ghaInstallationID != "" {
+			options.VCSRepo.GHAInstallationID = tfe.String(ghaInstallationID)
 		}
 
 		// Only set the branch if one is configured.
 		if branch, ok := vcsRepo["branch"].(string); ok && branch != "" {
 			options.VCSRepo.Branch = tfe.String(branch)
 		}
+
+		// Only set the tags regex if one is configured.
+		if tagsRegex, ok := vcsRepo["tags_regex"].(string); ok && tagsRegex != "" {
+			options.VCSRepo.TagsRegex = tfe.String(tagsRegex)
+		}
 	}
 
 	for _, tagName := range d.Get("tag_names").(*schema.Set).List() {
@@ -332,6 +407,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	// Update the config.
 	d.Set("name", workspace.Name)
 	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
+	d.Set("assessments_enabled", workspace.AssessmentsEnabled)
 	d.Set("auto_apply", workspace.AutoApply)
 	d.Set("description", workspace.Description)
 	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
@@ -342,9 +418,16 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("structured_run_output_enabled", workspace.StructuredRunOutputEnabled)
 	d.Set("terraform_version", workspace.TerraformVersion)
 	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
+	d.Set("trigger_patterns", workspace.TriggerPatterns)
 	d.Set("working_directory", workspace.WorkingDirectory)
 	d.Set("organization", workspace.Organization.Name)
 
+	var projectID string
+	if workspace.Project != nil {
+		projectID = workspace.Project.ID
+	}
+	d.Set("project_id", projectID)
+
 	var sshKeyID string
 	if workspace.SSHKey != nil {
 		sshKeyID = workspace.SSHKey.ID
@@ -366,10 +449,12 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	var vcsRepo []interface{}
 	if workspace.VCSRepo != nil {
 		vcsConfig := map[string]interface{}{
-			"identifier":         workspace.VCSRepo.Identifier,
-			"branch":             workspace.VCSRepo.Branch,
-			"ingress_submodules": workspace.VCSRepo.IngressSubmodules,
-			"oauth_token_id":     workspace.VCSRepo.OAuthTokenID,
+			"identifier":                 workspace.VCSRepo.Identifier,
+			"branch":                     workspace.VCSRepo.Branch,
+			"ingress_submodules":         workspace.VCSRepo.IngressSubmodules,
+			"oauth_token_id":             workspace.VCSRepo.OAuthTokenID,
+			"github_app_installation_id": workspace.VCSRepo.GHAInstallationID,
+			"tags_regex":                 workspace.VCSRepo.TagsRegex,
 		}
 		vcsRepo = append(vcsRepo, vcsConfig)
 	}
@@ -399,15 +484,18 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("name") || d.HasChange("auto_apply") || d.HasChange("queue_all_runs") ||
 		d.HasChange("terraform_version") || d.HasChange("working_directory") || d.HasChange("vcs_repo") ||
 		d.HasChange("file_triggers_enabled") || d.HasChange("trigger_prefixes") ||
+		d.HasChange("trigger_patterns") ||
 		d.HasChange("allow_destroy_plan") || d.HasChange("speculative_enabled") ||
 		d.HasChange("operations") || d.HasChange("execution_mode") ||
 		d.HasChange("description") || d.HasChange("agent_pool_id") ||
-		d.HasChange("global_remote_state") || d.HasChange("structured_run_output_enabled") {
+		d.HasChange("global_remote_state") || d.HasChange("structured_run_output_enabled") ||
+		d.HasChange("assessments_enabled") || d.HasChange("project_id") {
 
 		// Create a new options struct.
 		options := tfe.WorkspaceUpdateOptions{
 			Name:                       tfe.String(d.Get("name").(string)),
 			AllowDestroyPlan:           tfe.Bool(d.Get("allow_destroy_plan").(bool)),
+			AssessmentsEnabled:         tfe.Bool(d.Get("assessments_enabled").(bool)),
 			AutoApply:                  tfe.Bool(d.Get("auto_apply").(bool)),
 			Description:                tfe.String(d.Get("description").(string)),
 			FileTriggersEnabled:        tfe.Bool(d.Get("file_triggers_enabled").(bool)),
@@ -424,6 +512,12 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
+		if d.HasChange("project_id") {
+			if v, ok := d.GetOk("project_id"); ok && v.(string) != "" {
+				options.Project = &tfe.Project{ID: v.(string)}
+			}
+		}
+
 		if d.HasChange("execution_mode") {
 			if v, ok := d.GetOk("execution_mode"); ok {
 				options.ExecutionMode = tfe.String(v.(string))
@@ -450,6 +544,15 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			options.TriggerPrefixes = []string{}
 		}
 
+		if tps, ok := d.GetOk("trigger_patterns"); ok {
+			for _, tp := range tps.([]interface{}) {
+				options.TriggerPatterns = append(options.TriggerPatterns, tp.(string))
+			}
+		} else {
+			// Reset trigger patterns when none are present in the config.
+			options.TriggerPatterns = []string{}
+		}
+
 		if workingDir, ok := d.GetOk("working_directory"); ok {
 			options.WorkingDirectory = tfe.String(workingDir.(string))
 		}
@@ -462,7 +565,14 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				Identifier:        tfe.String(vcsRepo["identifier"].(string)),
 				Branch:            tfe.String(vcsRepo["branch"].(string)),
 				IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
-				OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+				TagsRegex:         tfe.String(vcsRepo["tags_regex"].(string)),
+			}
+
+			if tokenID, ok := vcsRepo["oauth_token_id"].(string); ok && tokenID != "" {
+				options.VCSRepo.OAuthTokenID = tfe.String(tokenID)
+			}
+			if ghaInstallationID, ok := vcsRepo["github_app_installation_id"].(string); ok && ghaInstallationID != "" {
+				options.VCSRepo.GHAInstallationID = tfe.String(ghaInstallationID)
 			}
 		}
 
@@ -628,6 +738,139 @@ func validateAgentExecution(_ context.Context, d *schema.ResourceDiff, meta inte
 	return nil
 }
 
+// validateTerraformVersion checks a changed terraform_version, either an exact
+// version or a version constraint, against the versions the instance offers,
+// so a typo is caught at plan time instead of during a run. When the versions
+// can't be listed, the version is left to be validated by the API.
+func validateTerraformVersion(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("terraform_version") || !d.NewValueKnown("terraform_version") {
+		return nil
+	}
+
+	tfVersion := d.Get("terraform_version").(string)
+	if tfVersion == "" || tfVersion == "latest" {
+		return nil
+	}
+
+	tfeClient := meta.(*tfe.Client)
+
+	versions, err := listTerraformVersions(tfeClient)
+	if err != nil {
+		log.Printf("[WARN] Skipping validation of terraform_version %s: %v", tfVersion, err)
+		return nil
+	}
+
+	// Deprecated versions are reported by warnDeprecatedTerraformVersion, as
+	// a CustomizeDiff can't return warnings.
+	if _, err := checkTerraformVersion(tfVersion, versions); err != nil {
+		return fmt.Errorf("invalid terraform_version: %v", err)
+	}
+
+	return nil
+}
+
+// warnDeprecatedTerraformVersion returns a validation function that warns when
+// terraform_version is, or resolves to, a deprecated version. Validation is
+// the only step of a plan that can return warnings, but it has no access to
+// the client, so the client is retrieved through meta. While the provider is
+// not configured, like during terraform validate, nothing is checked. The
+// versions are only listed once per client, as the configuration of every
+// workspace is validated.
+func warnDeprecatedTerraformVersion(meta func() interface{}) schema.SchemaValidateDiagFunc {
+	var mu sync.Mutex
+	var client *tfe.Client
+	var versions []*terraformToolVersion
+
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		tfVersion := v.(string)
+		if tfVersion == "" || tfVersion == "latest" {
+			return nil
+		}
+
+		tfeClient, ok := meta().(*tfe.Client)
+		if !ok || tfeClient == nil {
+			return nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if client != tfeClient {
+			l, err := listTerraformVersions(tfeClient)
+			if err != nil {
+				log.Printf("[WARN] Skipping deprecation check of terraform_version %s: %v", tfVersion, err)
+			}
+			client, versions = tfeClient, l
+		}
+
+		// Unknown and disabled versions are rejected when terraform_version
+		// changes, so only the warning is of interest here.
+		warning, err := checkTerraformVersion(tfVersion, versions)
+		if err != nil || warning == "" {
+			return nil
+		}
+
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       warning,
+			Detail:        "Runs of the workspace will use a deprecated Terraform version, which can be removed from the instance.",
+			AttributePath: path,
+		}}
+	}
+}
+
+// checkTerraformVersion checks that the given exact version or version
+// constraint matches at least one enabled version. It returns a warning when
+// the version is deprecated.
+func checkTerraformVersion(tfVersion string, versions []*terraformToolVersion) (string, error) {
+	for _, v := range versions {
+		if v.Version != tfVersion {
+			continue
+		}
+
+		if !v.Enabled {
+			return "", fmt.Errorf("Terraform version %s is disabled", tfVersion)
+		}
+		if v.Deprecated {
+			return fmt.Sprintf("Terraform version %s is deprecated", tfVersion), nil
+		}
+
+		return "", nil
+	}
+
+	constraints, err := version.NewConstraint(tfVersion)
+	if err != nil {
+		return "", fmt.Errorf("%s is neither an available Terraform version nor a version constraint", tfVersion)
+	}
+
+	// Track the highest matching version, as that is the one runs will use.
+	var latest *terraformToolVersion
+	var latestSemver *version.Version
+	for _, v := range versions {
+		if !v.Enabled {
+			continue
+		}
+
+		semver, err := version.NewVersion(v.Version)
+		if err != nil || !constraints.Check(semver) {
+			continue
+		}
+
+		if latestSemver == nil || semver.GreaterThan(latestSemver) {
+			latest, latestSemver = v, semver
+		}
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("no available Terraform version matches %s", tfVersion)
+	}
+	if latest.Deprecated {
+		return fmt.Sprintf("Terraform version %s matching %s is deprecated", latest.Version, tfVersion), nil
+	}
+
+	return "", nil
+}
+
 func validateRemoteState(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// If remote state consumers aren't set, the global setting can be either value and it
 	// doesn't matter.
@@ -645,6 +888,29 @@ func validateRemoteState(_ context.Context, d *schema.ResourceDiff, meta interfa
 	return nil
 }
 
+// Runs can be triggered by file changes (trigger_prefixes or trigger_patterns)
+// or by git tags (vcs_repo.tags_regex), but not by both. The conflicting
+// arguments are rejected by the schema, so this only checks what depends on
+// values and prior state.
+func validateVCSTriggers(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tagsRegex := d.Get("vcs_repo.0.tags_regex").(string)
+	triggerPatterns := d.Get("trigger_patterns").([]interface{})
+
+	if tagsRegex != "" && d.Get("file_triggers_enabled").(bool) {
+		return fmt.Errorf("file_triggers_enabled must be 'false' when setting vcs_repo.tags_regex")
+	}
+
+	// trigger_prefixes is computed and keeps its prior value once removed
+	// from the config, so clear it when switching to patterns or tags.
+	if tagsRegex != "" || len(triggerPatterns) > 0 {
+		if prefixes := d.Get("trigger_prefixes").([]interface{}); len(prefixes) > 0 {
+			return d.SetNew("trigger_prefixes", []string{})
+		}
+	}
+
+	return nil
+}
+
 func resourceTFEWorkspaceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tfeClient := meta.(*tfe.Client)
 
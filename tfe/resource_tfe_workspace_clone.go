@@ -0,0 +1,628 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFEWorkspaceClone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEWorkspaceCloneCreate,
+		Read:   resourceTFEWorkspaceCloneRead,
+		Update: resourceTFEWorkspaceCloneUpdate,
+		Delete: resourceTFEWorkspaceCloneDelete,
+
+		Schema: map[string]*schema.Schema{
+			"source_workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"copy_variables": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"copy_team_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"copy_notification_configurations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"copy_run_triggers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"delete_workspace_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"variables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"sensitive_variables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"hcl": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"team_access": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"notification_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"run_trigger_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceCloneCreate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	sourceID := d.Get("source_workspace_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Read configuration of source workspace: %s", sourceID)
+	source, err := tfeClient.Workspaces.ReadByID(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("Error reading configuration of source workspace %s: %v", sourceID, err)
+	}
+	organization := source.Organization.Name
+
+	options := cloneWorkspaceCreateOptions(source)
+	options.Name = tfe.String(name)
+
+	// Create the clone in the same project as the source workspace, unless
+	// another one is configured.
+	if v, ok := d.GetOk("project_id"); ok && v.(string) != "" {
+		options.Project = &tfe.Project{ID: v.(string)}
+	}
+
+	log.Printf("[DEBUG] Create workspace %s from source workspace: %s", name, sourceID)
+	workspace, err := tfeClient.Workspaces.Create(ctx, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating workspace %s for organization %s: %v", name, organization, err)
+	}
+
+	// Delete the workspace again when copying fails, so a retry doesn't
+	// conflict with a partially copied workspace nobody manages.
+	if err := cloneWorkspaceContents(d, tfeClient, source, workspace); err != nil {
+		log.Printf("[DEBUG] Delete partially copied workspace: %s", workspace.ID)
+		if derr := tfeClient.Workspaces.DeleteByID(ctx, workspace.ID); derr != nil {
+			return fmt.Errorf("%v\nError deleting partially copied workspace %s: %v", err, workspace.ID, derr)
+		}
+		return err
+	}
+
+	d.SetId(workspace.ID)
+
+	return resourceTFEWorkspaceCloneRead(d, meta)
+}
+
+func resourceTFEWorkspaceCloneRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	log.Printf("[DEBUG] Read configuration of workspace: %s", d.Id())
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of workspace %s: %v", d.Id(), err)
+	}
+
+	// Only the workspace itself is read, as the copied objects are meant to
+	// be imported into and managed by their own resources.
+	d.Set("name", workspace.Name)
+	d.Set("organization", workspace.Organization.Name)
+
+	var projectID string
+	if workspace.Project != nil {
+		projectID = workspace.Project.ID
+	}
+	d.Set("project_id", projectID)
+
+	return nil
+}
+
+func resourceTFEWorkspaceCloneUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Besides the name, only delete_workspace_on_destroy can be updated,
+	// which is only used when the resource is destroyed.
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+
+		log.Printf("[DEBUG] Rename cloned workspace %s to: %s", d.Id(), name)
+		_, err := tfeClient.Workspaces.UpdateByID(ctx, d.Id(), tfe.WorkspaceUpdateOptions{
+			Name: tfe.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Error renaming cloned workspace %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceTFEWorkspaceCloneRead(d, meta)
+}
+
+func resourceTFEWorkspaceCloneDelete(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	// Keep the workspace when requested, so it can be managed by a
+	// tfe_workspace resource after removing this resource.
+	if !d.Get("delete_workspace_on_destroy").(bool) {
+		log.Printf("[DEBUG] Remove cloned workspace %s from the state", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Delete cloned workspace: %s", d.Id())
+	err := tfeClient.Workspaces.DeleteByID(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting cloned workspace %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cloneWorkspaceContents copies the SSH key, remote state consumers and the
+// enabled kinds of objects of the source workspace to the new workspace.
+func cloneWorkspaceContents(d *schema.ResourceData, client *tfe.Client, source, workspace *tfe.Workspace) error {
+	name := workspace.Name
+	sourceID := source.ID
+
+	if source.SSHKey != nil {
+		_, err := client.Workspaces.AssignSSHKey(ctx, workspace.ID, tfe.WorkspaceAssignSSHKeyOptions{
+			SSHKeyID: tfe.String(source.SSHKey.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("Error assigning SSH key to workspace %s: %v", name, err)
+		}
+	}
+
+	if !source.GlobalRemoteState {
+		_, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(sourceID, client)
+		if err != nil {
+			return fmt.Errorf(
+				"Error reading remote state consumers for workspace %s: %v", sourceID, err)
+		}
+
+		if len(remoteStateConsumerIDs) > 0 {
+			options := tfe.WorkspaceAddRemoteStateConsumersOptions{}
+			for _, remoteStateConsumerID := range remoteStateConsumerIDs {
+				options.Workspaces = append(options.Workspaces, &tfe.Workspace{ID: remoteStateConsumerID.(string)})
+			}
+			err = client.Workspaces.AddRemoteStateConsumers(ctx, workspace.ID, options)
+			if err != nil {
+				return fmt.Errorf("Error adding remote state consumers to workspace %s: %v", name, err)
+			}
+		}
+	}
+
+	if d.Get("copy_variables").(bool) {
+		if err := cloneWorkspaceVariables(d, client, source, workspace); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("copy_team_access").(bool) {
+		if err := cloneWorkspaceTeamAccess(d, client, source, workspace); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("copy_notification_configurations").(bool) {
+		if err := cloneWorkspaceNotificationConfigurations(d, client, source, workspace); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("copy_run_triggers").(bool) {
+		if err := cloneWorkspaceRunTriggers(d, client, source, workspace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneWorkspaceCreateOptions returns the options to create a workspace with
+// the same settings as the given source workspace.
+func cloneWorkspaceCreateOptions(source *tfe.Workspace) tfe.WorkspaceCreateOptions {
+	options := tfe.WorkspaceCreateOptions{
+		AllowDestroyPlan:           tfe.Bool(source.AllowDestroyPlan),
+		AssessmentsEnabled:         tfe.Bool(source.AssessmentsEnabled),
+		AutoApply:                  tfe.Bool(source.AutoApply),
+		Description:                tfe.String(source.Description),
+		ExecutionMode:              tfe.String(source.ExecutionMode),
+		FileTriggersEnabled:        tfe.Bool(source.FileTriggersEnabled),
+		GlobalRemoteState:          tfe.Bool(source.GlobalRemoteState),
+		QueueAllRuns:               tfe.Bool(source.QueueAllRuns),
+		SpeculativeEnabled:         tfe.Bool(source.SpeculativeEnabled),
+		StructuredRunOutputEnabled: tfe.Bool(source.StructuredRunOutputEnabled),
+		TerraformVersion:           tfe.String(source.TerraformVersion),
+		WorkingDirectory:           tfe.String(source.WorkingDirectory),
+	}
+
+	if source.AgentPool != nil {
+		options.AgentPoolID = tfe.String(source.AgentPool.ID)
+	}
+
+	if source.Project != nil {
+		options.Project = &tfe.Project{ID: source.Project.ID}
+	}
+
+	// Trigger prefixes and trigger patterns can't be used together.
+	if len(source.TriggerPatterns) > 0 {
+		options.TriggerPatterns = source.TriggerPatterns
+	} else if len(source.TriggerPrefixes) > 0 {
+		options.TriggerPrefixes = source.TriggerPrefixes
+	}
+
+	if source.VCSRepo != nil {
+		options.VCSRepo = &tfe.VCSRepoOptions{
+			Identifier:        tfe.String(source.VCSRepo.Identifier),
+			IngressSubmodules: tfe.Bool(source.VCSRepo.IngressSubmodules),
+		}
+
+		if source.VCSRepo.OAuthTokenID != "" {
+			options.VCSRepo.OAuthTokenID = tfe.String(source.VCSRepo.OAuthTokenID)
+		}
+		if source.VCSRepo.GHAInstallationID != "" {
+			options.VCSRepo.GHAInstallationID = tfe.String(source.VCSRepo.GHAInstallationID)
+		}
+		if source.VCSRepo.Branch != "" {
+			options.VCSRepo.Branch = tfe.String(source.VCSRepo.Branch)
+		}
+		if source.VCSRepo.TagsRegex != "" {
+			options.VCSRepo.TagsRegex = tfe.String(source.VCSRepo.TagsRegex)
+		}
+	}
+
+	for _, tagName := range source.TagNames {
+		options.Tags = append(options.Tags, &tfe.Tag{Name: tagName})
+	}
+
+	return options
+}
+
+// cloneWorkspaceVariables copies the non-sensitive variables of the source
+// workspace. The values of sensitive variables can't be read, so those are
+// only reported as still needing a value.
+func cloneWorkspaceVariables(d *schema.ResourceData, client *tfe.Client, source, workspace *tfe.Workspace) error {
+	var variables []interface{}
+	var sensitiveVariables []interface{}
+
+	options := &tfe.VariableListOptions{}
+	for {
+		l, err := client.Variables.List(ctx, source.ID, options)
+		if err != nil {
+			return fmt.Errorf("Error reading variables of workspace %s: %v", source.ID, err)
+		}
+
+		for _, v := range l.Items {
+			if v.Sensitive {
+				sensitiveVariables = append(sensitiveVariables, map[string]interface{}{
+					"key":         v.Key,
+					"category":    string(v.Category),
+					"hcl":         v.HCL,
+					"description": v.Description,
+				})
+				continue
+			}
+
+			log.Printf("[DEBUG] Copy variable %s to workspace: %s", v.Key, workspace.ID)
+			variable, err := client.Variables.Create(ctx, workspace.ID, tfe.VariableCreateOptions{
+				Key:         tfe.String(v.Key),
+				Value:       tfe.String(v.Value),
+				Description: tfe.String(v.Description),
+				Category:    tfe.Category(v.Category),
+				HCL:         tfe.Bool(v.HCL),
+				Sensitive:   tfe.Bool(false),
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"Error copying variable %s to workspace %s: %v", v.Key, workspace.ID, err)
+			}
+
+			variables = append(variables, map[string]interface{}{
+				"id":        variable.ID,
+				"key":       variable.Key,
+				"category":  string(variable.Category),
+				"import_id": fmt.Sprintf("%s/%s/%s", workspace.Organization.Name, workspace.Name, variable.ID),
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.Set("variables", variables)
+	d.Set("sensitive_variables", sensitiveVariables)
+
+	return nil
+}
+
+// cloneWorkspaceTeamAccess grants every team with access to the source
+// workspace the same access to the cloned workspace.
+func cloneWorkspaceTeamAccess(d *schema.ResourceData, client *tfe.Client, source, workspace *tfe.Workspace) error {
+	var teamAccess []interface{}
+
+	options := &tfe.TeamAccessListOptions{
+		WorkspaceID: source.ID,
+	}
+	for {
+		l, err := client.TeamAccess.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error reading team access of workspace %s: %v", source.ID, err)
+		}
+
+		for _, ta := range l.Items {
+			if ta.Team == nil {
+				continue
+			}
+
+			addOptions := tfe.TeamAccessAddOptions{
+				Access:    tfe.Access(ta.Access),
+				Team:      &tfe.Team{ID: ta.Team.ID},
+				Workspace: &tfe.Workspace{ID: workspace.ID},
+			}
+
+			// The permissions can only be set for custom access, the other
+			// access levels imply them.
+			if ta.Access == tfe.AccessCustom {
+				addOptions.Runs = tfe.RunsPermission(ta.Runs)
+				addOptions.Variables = tfe.VariablesPermission(ta.Variables)
+				addOptions.StateVersions = tfe.StateVersionsPermission(ta.StateVersions)
+				addOptions.SentinelMocks = tfe.SentinelMocksPermission(ta.SentinelMocks)
+				addOptions.WorkspaceLocking = tfe.Bool(ta.WorkspaceLocking)
+				addOptions.RunTasks = tfe.Bool(ta.RunTasks)
+			}
+
+			log.Printf("[DEBUG] Copy access of team %s to workspace: %s", ta.Team.ID, workspace.ID)
+			access, err := client.TeamAccess.Add(ctx, addOptions)
+			if err != nil {
+				return fmt.Errorf(
+					"Error copying access of team %s to workspace %s: %v", ta.Team.ID, workspace.ID, err)
+			}
+
+			teamAccess = append(teamAccess, map[string]interface{}{
+				"id":        access.ID,
+				"team_id":   ta.Team.ID,
+				"import_id": fmt.Sprintf("%s/%s/%s", workspace.Organization.Name, workspace.Name, access.ID),
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.Set("team_access", teamAccess)
+
+	return nil
+}
+
+// cloneWorkspaceNotificationConfigurations copies the notification
+// configurations of the source workspace. Tokens can't be read, so copied
+// configurations don't have one.
+func cloneWorkspaceNotificationConfigurations(d *schema.ResourceData, client *tfe.Client, source, workspace *tfe.Workspace) error {
+	var notificationConfigurations []interface{}
+
+	options := &tfe.NotificationConfigurationListOptions{}
+	for {
+		l, err := client.NotificationConfigurations.List(ctx, source.ID, options)
+		if err != nil {
+			return fmt.Errorf(
+				"Error reading notification configurations of workspace %s: %v", source.ID, err)
+		}
+
+		for _, nc := range l.Items {
+			createOptions := tfe.NotificationConfigurationCreateOptions{
+				DestinationType: tfe.NotificationDestination(nc.DestinationType),
+				Enabled:         tfe.Bool(nc.Enabled),
+				Name:            tfe.String(nc.Name),
+				EmailAddresses:  nc.EmailAddresses,
+			}
+
+			if nc.URL != "" {
+				createOptions.URL = tfe.String(nc.URL)
+			}
+
+			for _, trigger := range nc.Triggers {
+				createOptions.Triggers = append(createOptions.Triggers, tfe.NotificationTriggerType(trigger))
+			}
+
+			for _, user := range nc.EmailUsers {
+				createOptions.EmailUsers = append(createOptions.EmailUsers, &tfe.User{ID: user.ID})
+			}
+
+			log.Printf("[DEBUG] Copy notification configuration %s to workspace: %s", nc.Name, workspace.ID)
+			notificationConfiguration, err := client.NotificationConfigurations.Create(ctx, workspace.ID, createOptions)
+			if err != nil {
+				return fmt.Errorf(
+					"Error copying notification configuration %s to workspace %s: %v", nc.Name, workspace.ID, err)
+			}
+
+			notificationConfigurations = append(notificationConfigurations, map[string]interface{}{
+				"id":   notificationConfiguration.ID,
+				"name": notificationConfiguration.Name,
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.Set("notification_configurations", notificationConfigurations)
+
+	return nil
+}
+
+// cloneWorkspaceRunTriggers connects the cloned workspace to the same source
+// workspaces as the source workspace.
+func cloneWorkspaceRunTriggers(d *schema.ResourceData, client *tfe.Client, source, workspace *tfe.Workspace) error {
+	runTriggers, err := listInboundRunTriggers(client, source.ID)
+	if err != nil {
+		return fmt.Errorf("Error reading run triggers of workspace %s: %v", source.ID, err)
+	}
+
+	runTriggerIDs := make(map[string]interface{}, len(runTriggers))
+	for sourceableID := range runTriggers {
+		log.Printf("[DEBUG] Copy run trigger from workspace %s to workspace: %s", sourceableID, workspace.ID)
+		runTrigger, err := createRunTrigger(client, workspace.ID, tfe.RunTriggerCreateOptions{
+			Sourceable: &tfe.Workspace{ID: sourceableID},
+		})
+		if err != nil {
+			return fmt.Errorf(
+				"Error copying run trigger from workspace %s to workspace %s: %v", sourceableID, workspace.ID, err)
+		}
+		runTriggerIDs[sourceableID] = runTrigger.ID
+	}
+
+	d.Set("run_trigger_ids", runTriggerIDs)
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEWorkspaceClone_basic(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// The cloned workspace is kept by default, but is deleted together
+		// with the organization.
+		CheckDestroy: testAccCheckTFEWorkspaceCloneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceClone_basic(rInt, "workspace-clone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace_clone.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "name", "workspace-clone"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "organization", orgName),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_clone.foobar", "project_id", "tfe_workspace.source", "project_id"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "delete_workspace_on_destroy", "false"),
+
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "variables.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "variables.0.key", "region"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "sensitive_variables.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "sensitive_variables.0.key", "API_TOKEN"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "sensitive_variables.0.category", "env"),
+
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "team_access.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_clone.foobar", "team_access.0.team_id", "tfe_team.foobar", "id"),
+
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "notification_configurations.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "notification_configurations.0.name", "notification_basic"),
+
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "run_trigger_ids.%", "1"),
+
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.clone", "auto_apply", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.clone", "working_directory", "infra"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.clone", "tag_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceClone_basic(rInt, "workspace-clone-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_clone.foobar", "name", "workspace-clone-renamed"),
+					resource.TestCheckResourceAttrPtr(
+						"tfe_workspace_clone.foobar", "id", &workspace.ID),
+				),
+			},
+		},
+	})
+}
+
+func TestCloneWorkspaceCreateOptions(t *testing.T) {
+	source := &tfe.Workspace{
+		ID:               "ws-source",
+		Name:             "payments-prod",
+		AutoApply:        true,
+		ExecutionMode:    "agent",
+		TerraformVersion: "1.5.0",
+		TriggerPrefixes:  []string{"modules/"},
+		TriggerPatterns:  []string{"/modules/**/*"},
+		WorkingDirectory: "infra",
+		AgentPool:        &tfe.AgentPool{ID: "apool-123"},
+		Project:          &tfe.Project{ID: "prj-123"},
+		TagNames:         []string{"payments", "prod"},
+		VCSRepo: &tfe.VCSRepo{
+			Identifier:   "my-org/payments",
+			OAuthTokenID: "ot-123",
+		},
+	}
+
+	options := cloneWorkspaceCreateOptions(source)
+
+	if options.Name != nil {
+		t.Fatalf("expected no name, got %q", *options.Name)
+	}
+	if !*options.AutoApply {
+		t.Fatal("expected auto apply to be copied")
+	}
+	if *options.ExecutionMode != "agent" || *options.AgentPoolID != "apool-123" {
+		t.Fatalf("expected agent execution with pool apool-123, got %q with pool %q",
+			*options.ExecutionMode, *options.AgentPoolID)
+	}
+	if options.Project.ID != "prj-123" {
+		t.Fatalf("expected project prj-123, got %q", options.Project.ID)
+	}
+	if !reflect.DeepEqual(options.TriggerPatterns, source.TriggerPatterns) || options.TriggerPrefixes != nil {
+		t.Fatalf("expected only trigger patterns to be copied, got patterns %v and prefixes %v",
+			options.TriggerPatterns, options.TriggerPrefixes)
+	}
+	if *options.VCSRepo.Identifier != "my-org/payments" || *options.VCSRepo.OAuthTokenID != "ot-123" {
+		t.Fatalf("expected VCS repo to be copied, got %v", options.VCSRepo)
+	}
+	if options.VCSRepo.Branch != nil || options.VCSRepo.GHAInstallationID != nil {
+		t.Fatal("expected unset VCS repo settings to be left out")
+	}
+	if len(options.Tags) != 2 || options.Tags[0].Name != "payments" || options.Tags[1].Name != "prod" {
+		t.Fatalf("expected tags payments and prod, got %v", options.Tags)
+	}
+}
+
+func testAccCheckTFEWorkspaceCloneDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*tfe.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_clone" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.Workspaces.ReadByID(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Cloned workspace %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceClone_basic(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "upstream" {
+  name         = "workspace-upstream"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "source" {
+  name              = "workspace-source"
+  organization      = tfe_organization.foobar.id
+  auto_apply        = true
+  working_directory = "infra"
+  tag_names         = ["payments"]
+}
+
+resource "tfe_variable" "region" {
+  key          = "region"
+  value        = "eu-west-1"
+  category     = "terraform"
+  workspace_id = tfe_workspace.source.id
+}
+
+resource "tfe_variable" "token" {
+  key          = "API_TOKEN"
+  value        = "secret"
+  category     = "env"
+  sensitive    = true
+  workspace_id = tfe_workspace.source.id
+}
+
+resource "tfe_team_access" "foobar" {
+  access       = "write"
+  team_id      = tfe_team.foobar.id
+  workspace_id = tfe_workspace.source.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_basic"
+  destination_type = "generic"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.source.id
+}
+
+resource "tfe_run_trigger" "foobar" {
+  workspace_id  = tfe_workspace.source.id
+  sourceable_id = tfe_workspace.upstream.id
+}
+
+resource "tfe_workspace_clone" "foobar" {
+  source_workspace_id = tfe_workspace.source.id
+  name                = "%s"
+
+  depends_on = [
+    tfe_variable.region,
+    tfe_variable.token,
+    tfe_team_access.foobar,
+    tfe_notification_configuration.foobar,
+    tfe_run_trigger.foobar,
+  ]
+}
+
+data "tfe_workspace" "clone" {
+  name         = tfe_workspace_clone.foobar.name
+  organization = tfe_workspace_clone.foobar.organization
+}`, rInt, name)
+}
@@ -0,0 +1,456 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workspaceSettingsPolicyKeys are the settings a policy can enforce, at
+// least one of which has to be configured.
+var workspaceSettingsPolicyKeys = []string{
+	"allow_destroy_plan",
+	"assessments_enabled",
+	"auto_apply",
+	"file_triggers_enabled",
+	"speculative_enabled",
+	"structured_run_output_enabled",
+	"terraform_version",
+	"minimum_terraform_version",
+}
+
+// workspaceSettingsPolicy holds the enforced settings. Settings that are not
+// enforced are nil.
+type workspaceSettingsPolicy struct {
+	AllowDestroyPlan           *bool
+	AssessmentsEnabled         *bool
+	AutoApply                  *bool
+	FileTriggersEnabled        *bool
+	SpeculativeEnabled         *bool
+	StructuredRunOutputEnabled *bool
+	TerraformVersion           *string
+	MinimumTerraformVersion    *version.Version
+}
+
+func resourceTFEWorkspaceSettingsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEWorkspaceSettingsPolicyCreate,
+		Read:   resourceTFEWorkspaceSettingsPolicyRead,
+		Update: resourceTFEWorkspaceSettingsPolicyUpdate,
+		Delete: resourceTFEWorkspaceSettingsPolicyDelete,
+
+		CustomizeDiff: resourceTFEWorkspaceSettingsPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tag_names": {
+				Type:         schema.TypeList,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				AtLeastOneOf: []string{"tag_names", "name_patterns"},
+			},
+
+			"name_patterns": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"allow_destroy_plan": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				AtLeastOneOf: workspaceSettingsPolicyKeys,
+			},
+
+			"assessments_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"auto_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"file_triggers_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"speculative_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"structured_run_output_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"terraform_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"minimum_terraform_version"},
+			},
+
+			"minimum_terraform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := version.NewVersion(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s must be an exact version: %v", k, err)}
+					}
+					return nil, nil
+				},
+			},
+
+			"workspace_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"drift": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"changes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceSettingsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	// The selectors can be updated in place, so they can't be part of the ID.
+	d.SetId(resource.PrefixedUniqueId(d.Get("organization").(string) + "/"))
+
+	return resourceTFEWorkspaceSettingsPolicyUpdate(d, meta)
+}
+
+func resourceTFEWorkspaceSettingsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	workspaces, err := listWorkspaceSettingsPolicyWorkspaces(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	policy, versions, err := expandWorkspaceSettingsPolicy(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	workspaceIDs := make(map[string]interface{}, len(workspaces))
+	drift := make(map[string]interface{})
+	for _, w := range workspaces {
+		workspaceIDs[w.Name] = w.ID
+
+		if diffs, _ := workspaceSettingsDrift(w, policy, versions); len(diffs) > 0 {
+			drift[w.Name] = strings.Join(diffs, "; ")
+		}
+	}
+
+	d.Set("workspace_ids", workspaceIDs)
+	d.Set("drift", drift)
+
+	return nil
+}
+
+func resourceTFEWorkspaceSettingsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(*tfe.Client)
+
+	workspaces, err := listWorkspaceSettingsPolicyWorkspaces(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	policy, versions, err := expandWorkspaceSettingsPolicy(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	changes := make(map[string]interface{})
+	for _, w := range workspaces {
+		diffs, options := workspaceSettingsDrift(w, policy, versions)
+		if len(diffs) == 0 {
+			continue
+		}
+
+		// Only the drifted settings are sent, so the rest of the workspace
+		// is left as is.
+		log.Printf("[DEBUG] Enforce settings on workspace %s: %s", w.ID, strings.Join(diffs, "; "))
+		_, err := tfeClient.Workspaces.UpdateByID(ctx, w.ID, options)
+		if err != nil {
+			return fmt.Errorf("Error enforcing settings on workspace %s: %v", w.ID, err)
+		}
+
+		changes[w.Name] = strings.Join(diffs, "; ")
+	}
+	d.Set("changes", changes)
+
+	return resourceTFEWorkspaceSettingsPolicyRead(d, meta)
+}
+
+func resourceTFEWorkspaceSettingsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	// The enforced settings are left in place on the workspaces.
+	log.Printf("[DEBUG] Stop enforcing workspace settings policy: %s", d.Id())
+	return nil
+}
+
+// resourceTFEWorkspaceSettingsPolicyCustomizeDiff computes the changes the
+// next apply makes from the planned selectors and settings, so the plan lists
+// every workspace that will be updated, including when the enforced settings
+// themselves change.
+func resourceTFEWorkspaceSettingsPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Any drift found when refreshing is corrected by the next apply.
+	drifted := len(d.Get("drift").(map[string]interface{})) > 0
+	if drifted {
+		if err := d.SetNew("drift", map[string]interface{}{}); err != nil {
+			return err
+		}
+	}
+
+	keys := append([]string{"organization", "tag_names", "name_patterns", "project_id"}, workspaceSettingsPolicyKeys...)
+
+	// Leave the recorded changes alone when nothing is going to be applied.
+	changed := d.Id() == "" || drifted
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("changes")
+		}
+		changed = changed || d.HasChange(key)
+	}
+	if !changed {
+		return nil
+	}
+
+	tfeClient := meta.(*tfe.Client)
+
+	workspaces, err := listWorkspaceSettingsPolicyWorkspaces(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	policy, versions, err := expandWorkspaceSettingsPolicy(d, tfeClient)
+	if err != nil {
+		return err
+	}
+
+	changes := make(map[string]interface{})
+	for _, w := range workspaces {
+		if diffs, _ := workspaceSettingsDrift(w, policy, versions); len(diffs) > 0 {
+			changes[w.Name] = strings.Join(diffs, "; ")
+		}
+	}
+
+	return d.SetNew("changes", changes)
+}
+
+// workspaceSettingsPolicyConfig is implemented by both schema.ResourceData
+// and schema.ResourceDiff, so the policy can be read when planning as well
+// as when applying.
+type workspaceSettingsPolicyConfig interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+	GetOkExists(string) (interface{}, bool)
+}
+
+// listWorkspaceSettingsPolicyWorkspaces returns the workspaces selected by
+// the tag names, name patterns and project of the policy.
+func listWorkspaceSettingsPolicyWorkspaces(d workspaceSettingsPolicyConfig, client *tfe.Client) ([]*tfe.Workspace, error) {
+	organization := d.Get("organization").(string)
+
+	var tagNames []string
+	for _, tagName := range d.Get("tag_names").([]interface{}) {
+		if tagName != nil && len(strings.TrimSpace(tagName.(string))) != 0 {
+			tagNames = append(tagNames, tagName.(string))
+		}
+	}
+
+	var patterns []string
+	for _, pattern := range d.Get("name_patterns").([]interface{}) {
+		if pattern != nil {
+			patterns = append(patterns, pattern.(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Read workspaces of organization: %s", organization)
+	workspaces, err := listWorkspacesBySelector(client, organization, []string{"*"}, tagNames, d.Get("project_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(patterns) == 0 {
+		return workspaces, nil
+	}
+
+	var selected []*tfe.Workspace
+	for _, w := range workspaces {
+		matched, err := matchWorkspaceName(w.Name, patterns)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			selected = append(selected, w)
+		}
+	}
+
+	return selected, nil
+}
+
+// matchWorkspaceName reports whether the workspace name matches any of the
+// given glob patterns.
+func matchWorkspaceName(name string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid workspace name pattern %q: %v", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// expandWorkspaceSettingsPolicy returns the configured settings, along with
+// the available Terraform versions when a minimum version is enforced.
+func expandWorkspaceSettingsPolicy(d workspaceSettingsPolicyConfig, client *tfe.Client) (*workspaceSettingsPolicy, []*terraformToolVersion, error) {
+	policy := &workspaceSettingsPolicy{}
+
+	if v, ok := d.GetOkExists("allow_destroy_plan"); ok {
+		policy.AllowDestroyPlan = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("assessments_enabled"); ok {
+		policy.AssessmentsEnabled = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("auto_apply"); ok {
+		policy.AutoApply = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("file_triggers_enabled"); ok {
+		policy.FileTriggersEnabled = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("speculative_enabled"); ok {
+		policy.SpeculativeEnabled = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("structured_run_output_enabled"); ok {
+		policy.StructuredRunOutputEnabled = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("terraform_version"); ok {
+		policy.TerraformVersion = tfe.String(v.(string))
+	}
+
+	v, ok := d.GetOk("minimum_terraform_version")
+	if !ok {
+		return policy, nil, nil
+	}
+
+	minimum, err := version.NewVersion(v.(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error parsing minimum Terraform version %s: %v", v, err)
+	}
+	policy.MinimumTerraformVersion = minimum
+
+	// The versions are needed to resolve the version constraints of
+	// workspaces.
+	versions, err := listTerraformVersions(client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error listing Terraform versions: %v", err)
+	}
+
+	return policy, versions, nil
+}
+
+// workspaceSettingsDrift compares the settings of a workspace against the
+// policy. It returns a description of every drifted setting, and the options
+// to update only those settings.
+func workspaceSettingsDrift(w *tfe.Workspace, policy *workspaceSettingsPolicy, versions []*terraformToolVersion) ([]string, tfe.WorkspaceUpdateOptions) {
+	var diffs []string
+	options := tfe.WorkspaceUpdateOptions{}
+
+	checkBool := func(name string, want *bool, got bool, set **bool) {
+		if want != nil && *want != got {
+			diffs = append(diffs, fmt.Sprintf("%s is %t, expected %t", name, got, *want))
+			*set = tfe.Bool(*want)
+		}
+	}
+
+	checkBool("allow_destroy_plan", policy.AllowDestroyPlan, w.AllowDestroyPlan, &options.AllowDestroyPlan)
+	checkBool("assessments_enabled", policy.AssessmentsEnabled, w.AssessmentsEnabled, &options.AssessmentsEnabled)
+	checkBool("auto_apply", policy.AutoApply, w.AutoApply, &options.AutoApply)
+	checkBool("file_triggers_enabled", policy.FileTriggersEnabled, w.FileTriggersEnabled, &options.FileTriggersEnabled)
+	checkBool("speculative_enabled", policy.SpeculativeEnabled, w.SpeculativeEnabled, &options.SpeculativeEnabled)
+	checkBool("structured_run_output_enabled", policy.StructuredRunOutputEnabled, w.StructuredRunOutputEnabled, &options.StructuredRunOutputEnabled)
+
+	if policy.TerraformVersion != nil && *policy.TerraformVersion != w.TerraformVersion {
+		diffs = append(diffs, fmt.Sprintf(
+			"terraform_version is %s, expected %s", w.TerraformVersion, *policy.TerraformVersion))
+		options.TerraformVersion = policy.TerraformVersion
+	}
+
+	if policy.MinimumTerraformVersion != nil && w.TerraformVersion != "latest" {
+		resolved := resolveTerraformVersion(w.TerraformVersion, versions)
+		if resolved == nil || resolved.LessThan(policy.MinimumTerraformVersion) {
+			diffs = append(diffs, fmt.Sprintf(
+				"terraform_version is %s, expected at least %s", w.TerraformVersion, policy.MinimumTerraformVersion))
+			options.TerraformVersion = tfe.String(policy.MinimumTerraformVersion.String())
+		}
+	}
+
+	return diffs, options
+}
+
+// resolveTerraformVersion returns the version runs use for the given exact
+// version or version constraint, or nil if it can't be resolved.
+func resolveTerraformVersion(tfVersion string, versions []*terraformToolVersion) *version.Version {
+	if v, err := version.NewVersion(tfVersion); err == nil {
+		return v
+	}
+
+	constraints, err := version.NewConstraint(tfVersion)
+	if err != nil {
+		return nil
+	}
+
+	// Runs use the highest enabled version matching the constraint.
+	var latest *version.Version
+	for _, v := range versions {
+		if !v.Enabled {
+			continue
+		}
+
+		semver, err := version.NewVersion(v.Version)
+		if err != nil || !constraints.Check(semver) {
+			continue
+		}
+
+		if latest == nil || semver.GreaterThan(latest) {
+			latest = semver
+		}
+	}
+
+	return latest
+}
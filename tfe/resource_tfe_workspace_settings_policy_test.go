@@ -0,0 +1,246 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceSettingsPolicy_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceSettingsPolicy_basic(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings_policy.foobar", "workspace_ids.%", "2"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_settings_policy.foobar", "workspace_ids.platform-a",
+						"tfe_workspace.platform_a", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings_policy.foobar", "drift.%", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings_policy.foobar", "changes.%", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings_policy.foobar", "changes.platform-a",
+						"auto_apply is true, expected false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.platform_a", "auto_apply", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace.other", "auto_apply", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceSettingsDrift(t *testing.T) {
+	versions := []*terraformToolVersion{
+		{Version: "1.4.6", Enabled: true},
+		{Version: "1.5.7", Enabled: true},
+		{Version: "1.6.0", Enabled: false},
+	}
+
+	cases := map[string]struct {
+		workspace *tfe.Workspace
+		policy    *workspaceSettingsPolicy
+		diffs     []string
+		options   tfe.WorkspaceUpdateOptions
+	}{
+		"compliant": {
+			workspace: &tfe.Workspace{AutoApply: false, TerraformVersion: "1.5.7"},
+			policy: &workspaceSettingsPolicy{
+				AutoApply:               tfe.Bool(false),
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+		},
+		"unenforced settings are ignored": {
+			workspace: &tfe.Workspace{AutoApply: true, AllowDestroyPlan: true},
+			policy: &workspaceSettingsPolicy{
+				StructuredRunOutputEnabled: tfe.Bool(false),
+			},
+		},
+		"drifted settings": {
+			workspace: &tfe.Workspace{AutoApply: true, AllowDestroyPlan: true, StructuredRunOutputEnabled: true},
+			policy: &workspaceSettingsPolicy{
+				AllowDestroyPlan:           tfe.Bool(false),
+				AutoApply:                  tfe.Bool(false),
+				StructuredRunOutputEnabled: tfe.Bool(true),
+			},
+			diffs: []string{
+				"allow_destroy_plan is true, expected false",
+				"auto_apply is true, expected false",
+			},
+			options: tfe.WorkspaceUpdateOptions{
+				AllowDestroyPlan: tfe.Bool(false),
+				AutoApply:        tfe.Bool(false),
+			},
+		},
+		"exact terraform version": {
+			workspace: &tfe.Workspace{TerraformVersion: "1.4.6"},
+			policy: &workspaceSettingsPolicy{
+				TerraformVersion: tfe.String("1.5.7"),
+			},
+			diffs:   []string{"terraform_version is 1.4.6, expected 1.5.7"},
+			options: tfe.WorkspaceUpdateOptions{TerraformVersion: tfe.String("1.5.7")},
+		},
+		"terraform version below minimum": {
+			workspace: &tfe.Workspace{TerraformVersion: "1.4.6"},
+			policy: &workspaceSettingsPolicy{
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+			diffs:   []string{"terraform_version is 1.4.6, expected at least 1.5.0"},
+			options: tfe.WorkspaceUpdateOptions{TerraformVersion: tfe.String("1.5.0")},
+		},
+		"constraint resolving above minimum": {
+			workspace: &tfe.Workspace{TerraformVersion: "~> 1.4"},
+			policy: &workspaceSettingsPolicy{
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+		},
+		"constraint resolving below minimum": {
+			workspace: &tfe.Workspace{TerraformVersion: "~> 1.4.0"},
+			policy: &workspaceSettingsPolicy{
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+			diffs:   []string{"terraform_version is ~> 1.4.0, expected at least 1.5.0"},
+			options: tfe.WorkspaceUpdateOptions{TerraformVersion: tfe.String("1.5.0")},
+		},
+		"constraint matching only disabled versions": {
+			workspace: &tfe.Workspace{TerraformVersion: ">= 1.6.0"},
+			policy: &workspaceSettingsPolicy{
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+			diffs:   []string{"terraform_version is >= 1.6.0, expected at least 1.5.0"},
+			options: tfe.WorkspaceUpdateOptions{TerraformVersion: tfe.String("1.5.0")},
+		},
+		"latest terraform version": {
+			workspace: &tfe.Workspace{TerraformVersion: "latest"},
+			policy: &workspaceSettingsPolicy{
+				MinimumTerraformVersion: version.Must(version.NewVersion("1.5.0")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diffs, options := workspaceSettingsDrift(tc.workspace, tc.policy, versions)
+
+			if !reflect.DeepEqual(diffs, tc.diffs) {
+				t.Fatalf("expected drift %v, got %v", tc.diffs, diffs)
+			}
+			if !reflect.DeepEqual(options, tc.options) {
+				t.Fatalf("expected update options %+v, got %+v", tc.options, options)
+			}
+		})
+	}
+}
+
+func TestMatchWorkspaceName(t *testing.T) {
+	cases := map[string]struct {
+		name     string
+		patterns []string
+		matched  bool
+		err      bool
+	}{
+		"exact name": {
+			name:     "payments-prod",
+			patterns: []string{"payments-prod"},
+			matched:  true,
+		},
+		"wildcard": {
+			name:     "payments-prod",
+			patterns: []string{"billing-*", "*-prod"},
+			matched:  true,
+		},
+		"no match": {
+			name:     "payments-dev",
+			patterns: []string{"*-prod"},
+			matched:  false,
+		},
+		"invalid pattern": {
+			name:     "payments-prod",
+			patterns: []string{"[payments"},
+			err:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			matched, err := matchWorkspaceName(tc.name, tc.patterns)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error is %t, got %v", tc.err, err)
+			}
+			if matched != tc.matched {
+				t.Fatalf("expected matched to be %t, got %t", tc.matched, matched)
+			}
+		})
+	}
+}
+
+func testAccTFEWorkspaceSettingsPolicy_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "platform_a" {
+  name         = "platform-a"
+  organization = tfe_organization.foobar.id
+  auto_apply   = true
+  tag_names    = ["platform"]
+
+  lifecycle {
+    ignore_changes = [auto_apply]
+  }
+}
+
+resource "tfe_workspace" "platform_b" {
+  name         = "platform-b"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["platform"]
+}
+
+resource "tfe_workspace" "other" {
+  name         = "other"
+  organization = tfe_organization.foobar.id
+  auto_apply   = true
+}
+
+resource "tfe_workspace_settings_policy" "foobar" {
+  organization = tfe_organization.foobar.name
+  tag_names    = ["platform"]
+  auto_apply   = false
+
+  depends_on = [
+    tfe_workspace.platform_a,
+    tfe_workspace.platform_b,
+    tfe_workspace.other,
+  ]
+}
+
+data "tfe_workspace" "platform_a" {
+  name         = tfe_workspace.platform_a.name
+  organization = tfe_organization.foobar.name
+
+  depends_on = [tfe_workspace_settings_policy.foobar]
+}
+
+data "tfe_workspace" "other" {
+  name         = tfe_workspace.other.name
+  organization = tfe_organization.foobar.name
+
+  depends_on = [tfe_workspace_settings_policy.foobar]
+}`, rInt)
+}
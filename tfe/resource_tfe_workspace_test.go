@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +38,8 @@ func TestAccTFEWorkspace_basic(t *testing.T) {
 						"tfe_workspace.foobar", "description", "My favorite workspace!"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "allow_destroy_plan", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "assessments_enabled", "false"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "auto_apply", "true"),
 					resource.TestCheckResourceAttr(
@@ -366,6 +370,156 @@ func TestAccTFEWorkspace_updateTriggerPrefixes(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_updateTriggerPatterns(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_triggerPrefixes(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "0"),
+				),
+			},
+
+			{
+				Config: testAccTFEWorkspace_triggerPatterns(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.0", "/modules/**/*"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.1", "/**/networking/*"),
+				),
+			},
+
+			{
+				Config: testAccTFEWorkspace_updateEmptyTriggerPatterns(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_patterns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_triggerPatternsAndPrefixesConflict(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEWorkspace_triggerPatternsAndPrefixes(rInt),
+				ExpectError: regexp.MustCompile(`"trigger_patterns": conflicts with trigger_prefixes`),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_vcsRepoTagsRegex(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_TOKEN == "" {
+				t.Skip("Please set GITHUB_TOKEN to run this test")
+			}
+			if GITHUB_WORKSPACE_IDENTIFIER == "" {
+				t.Skip("Please set GITHUB_WORKSPACE_IDENTIFIER to run this test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEWorkspace_vcsRepoTagsRegex(rInt, true),
+				ExpectError: regexp.MustCompile(`file_triggers_enabled must be 'false' when setting vcs_repo.tags_regex`),
+			},
+			{
+				Config: testAccTFEWorkspace_vcsRepoTagsRegex(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.identifier", GITHUB_WORKSPACE_IDENTIFIER),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.tags_regex", `\d+.\d+.\d+`),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_vcsRepoGithubAppInstallation(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_APP_INSTALLATION_ID == "" {
+				t.Skip("Please set GITHUB_APP_INSTALLATION_ID to run this test")
+			}
+			if GITHUB_WORKSPACE_IDENTIFIER == "" {
+				t.Skip("Please set GITHUB_WORKSPACE_IDENTIFIER to run this test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_vcsRepoGithubAppInstallation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.identifier", GITHUB_WORKSPACE_IDENTIFIER),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.github_app_installation_id", GITHUB_APP_INSTALLATION_ID),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "vcs_repo.0.oauth_token_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_vcsRepoOAuthTokenAndGithubAppInstallationConflict(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEWorkspace_vcsRepoOAuthTokenAndGithubAppInstallation(rInt),
+				ExpectError: regexp.MustCompile(`only one of .vcs_repo.0.github_app_installation_id,vcs_repo.0.oauth_token_id.\s+can be specified`),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_changeTags(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -545,6 +699,72 @@ func TestAccTFEWorkspace_structuredRunOutputDisabled(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_updateAssessmentsEnabled(t *testing.T) {
+	skipIfFreeOnly(t)
+
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "assessments_enabled", "false"),
+				),
+			},
+
+			{
+				Config: testAccTFEWorkspace_updateAssessmentsEnabled(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "assessments_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_updateProject(t *testing.T) {
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace.foobar", "project_id"),
+				),
+			},
+
+			{
+				Config: testAccTFEWorkspace_project(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace.foobar", "project_id", "tfe_project.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_updateVCSRepo(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1638,6 +1858,123 @@ resource "tfe_workspace" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEWorkspace_triggerPatterns(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name             = "workspace"
+  organization     = tfe_organization.foobar.id
+  trigger_patterns = ["/modules/**/*", "/**/networking/*"]
+}`, rInt)
+}
+
+func testAccTFEWorkspace_updateEmptyTriggerPatterns(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name             = "workspace"
+  organization     = tfe_organization.foobar.id
+  trigger_patterns = []
+}`, rInt)
+}
+
+func testAccTFEWorkspace_triggerPatternsAndPrefixes(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name             = "workspace"
+  organization     = tfe_organization.foobar.id
+  trigger_prefixes = ["/modules", "/shared"]
+  trigger_patterns = ["/modules/**/*"]
+}`, rInt)
+}
+
+func testAccTFEWorkspace_vcsRepoTagsRegex(rInt int, fileTriggersEnabled bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_oauth_client" "test" {
+  organization     = tfe_organization.foobar.id
+  api_url          = "https://api.github.com"
+  http_url         = "https://github.com"
+  oauth_token      = "%s"
+  service_provider = "github"
+}
+
+resource "tfe_workspace" "foobar" {
+  name                  = "workspace-test"
+  organization          = tfe_organization.foobar.id
+  file_triggers_enabled = %t
+  vcs_repo {
+    identifier     = "%s"
+    oauth_token_id = tfe_oauth_client.test.oauth_token_id
+    tags_regex     = "\\d+.\\d+.\\d+"
+  }
+}
+`,
+		rInt,
+		GITHUB_TOKEN,
+		fileTriggersEnabled,
+		GITHUB_WORKSPACE_IDENTIFIER,
+	)
+}
+
+func testAccTFEWorkspace_vcsRepoGithubAppInstallation(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+  vcs_repo {
+    identifier                 = "%s"
+    github_app_installation_id = "%s"
+  }
+}
+`,
+		rInt,
+		GITHUB_WORKSPACE_IDENTIFIER,
+		GITHUB_APP_INSTALLATION_ID,
+	)
+}
+
+func testAccTFEWorkspace_vcsRepoOAuthTokenAndGithubAppInstallation(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+  vcs_repo {
+    identifier                 = "hashicorp/terraform-random-module"
+    oauth_token_id             = "ot-XXXXXXXXXXXXXXXX"
+    github_app_installation_id = "ghain-XXXXXXXXXXXXXXXX"
+  }
+}
+`, rInt)
+}
+
 func testAccTFEWorkspace_updateAddVCSRepo(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -1847,3 +2184,120 @@ resource "tfe_workspace" "foobar" {
   structured_run_output_enabled = false
 }`, rInt)
 }
+
+func testAccTFEWorkspace_project(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name               = "workspace-test"
+  organization       = tfe_organization.foobar.id
+  description        = "My favorite workspace!"
+  allow_destroy_plan = false
+  auto_apply         = true
+  tag_names          = ["fav", "test"]
+  project_id         = tfe_project.foobar.id
+}`, rInt)
+}
+
+func testAccTFEWorkspace_updateAssessmentsEnabled(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name                = "workspace-test"
+  organization        = tfe_organization.foobar.id
+  description         = "My favorite workspace!"
+  allow_destroy_plan  = false
+  assessments_enabled = true
+  auto_apply          = true
+  terraform_version   = "1.2.0"
+  tag_names           = ["fav", "test"]
+}`, rInt)
+}
+
+func TestCheckTerraformVersion(t *testing.T) {
+	versions := []*terraformToolVersion{
+		{Version: "1.1.9", Enabled: true, Deprecated: true},
+		{Version: "1.2.0", Enabled: true},
+		{Version: "1.2.1", Enabled: true},
+		{Version: "1.3.0", Enabled: false},
+		{Version: "1.4.0-custom", Enabled: true},
+	}
+
+	cases := map[string]struct {
+		version string
+		warning bool
+		err     bool
+	}{
+		"exact version":                  {version: "1.2.0"},
+		"exact custom version":           {version: "1.4.0-custom"},
+		"deprecated exact version":       {version: "1.1.9", warning: true},
+		"disabled exact version":         {version: "1.3.0", err: true},
+		"unknown exact version":          {version: "1.2.2", err: true},
+		"matching constraint":            {version: "~> 1.2.0"},
+		"deprecated matching version":    {version: "~> 1.1.0", warning: true},
+		"only disabled versions match":   {version: "~> 1.3.0", err: true},
+		"no matching version":            {version: ">= 2.0.0", err: true},
+		"neither version nor constraint": {version: "1.2.O", err: true},
+	}
+
+	for name, tc := range cases {
+		warning, err := checkTerraformVersion(tc.version, versions)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error %t, got: %v", name, tc.err, err)
+		}
+		if (warning != "") != tc.warning {
+			t.Fatalf("%s: expected warning %t, got: %q", name, tc.warning, warning)
+		}
+	}
+}
+
+func TestWarnDeprecatedTerraformVersion(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{
+		terraformVersions: []*tfe.AdminTerraformVersion{
+			{Version: "1.1.9", Enabled: true, Deprecated: true},
+			{Version: "1.2.0", Enabled: true},
+		},
+	})
+	unavailable := testTfeClient(t, testClientOptions{
+		terraformVersionsError: tfe.ErrResourceNotFound,
+	})
+
+	cases := map[string]struct {
+		meta    interface{}
+		version string
+		warning bool
+	}{
+		"deprecated exact version":    {meta: client, version: "1.1.9", warning: true},
+		"deprecated matching version": {meta: client, version: "~> 1.1.0", warning: true},
+		"current version":             {meta: client, version: "1.2.0"},
+		"unknown version":             {meta: client, version: "1.2.2"},
+		"latest":                      {meta: client, version: "latest"},
+		"versions unavailable":        {meta: unavailable, version: "1.1.9"},
+		"provider not configured":     {meta: nil, version: "1.1.9"},
+	}
+
+	for name, tc := range cases {
+		validate := warnDeprecatedTerraformVersion(func() interface{} { return tc.meta })
+
+		diags := validate(tc.version, cty.GetAttrPath("terraform_version"))
+		if diags.HasError() {
+			t.Fatalf("%s: expected no errors, got: %v", name, diags)
+		}
+		if (len(diags) > 0) != tc.warning {
+			t.Fatalf("%s: expected warning %t, got: %v", name, tc.warning, diags)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+policy "enforce-mandatory-tags" {
+  source = "./missing.sentinel"
+  enforcement_level = "not-a-level"
@@ -10,6 +10,13 @@ import (
 type testClientOptions struct {
 	defaultWorkspaceID           string
 	remoteStateConsumersResponse string
+	readmes                      map[string]string
+	readmeErrors                 map[string]error
+	plans                        map[string]*tfe.Plan
+	planExports                  map[string]*tfe.PlanExport
+	planExportBundle             []byte
+	terraformVersions            []*tfe.AdminTerraformVersion
+	terraformVersionsError       error
 }
 
 // testTfeClient creates a mock client that creates workspaces with their ID
@@ -25,6 +32,9 @@ func testTfeClient(t *testing.T, options testClientOptions) *tfe.Client {
 	}
 
 	client.Workspaces = newMockWorkspaces(options)
+	client.Plans = newMockPlans(options)
+	client.PlanExports = newMockPlanExports(options)
+	client.Admin.TerraformVersions = newMockAdminTerraformVersions(options)
 
 	return client
 }
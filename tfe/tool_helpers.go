@@ -1,50 +1,172 @@
 package tfe
 
 import (
+	"errors"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
 
+// errTerraformVersionsUnavailable is returned when the Terraform versions
+// can't be listed, as only the admin API offers them.
+var errTerraformVersionsUnavailable = errors.New("Terraform versions can't be listed without site administrator access")
+
+// terraformToolVersion holds the attributes of a Terraform version that are
+// used to select and validate the version of a workspace.
+type terraformToolVersion struct {
+	ID         string
+	Version    string
+	Official   bool
+	Enabled    bool
+	Beta       bool
+	Deprecated bool
+}
+
+// toolVersion holds the fields shared by Terraform, Sentinel and OPA versions.
+type toolVersion struct {
+	ID      string
+	Version string
+}
+
+// toolVersionListFunc lists a single page of the versions of a tool, only
+// returning the given version if filter is not empty.
+type toolVersionListFunc func(filter string, pageNumber int) ([]toolVersion, *tfe.Pagination, error)
+
 // fetchTerraformVersionID returns a Terraform Version ID for the given Terraform version number
 func fetchTerraformVersionID(version string, client *tfe.Client) (string, error) {
-	versions, err := client.Admin.TerraformVersions.List(ctx, &tfe.AdminTerraformVersionsListOptions{
-		Filter: version,
+	return fetchToolVersionID("Terraform", version, func(filter string, pageNumber int) ([]toolVersion, *tfe.Pagination, error) {
+		options := &tfe.AdminTerraformVersionsListOptions{Filter: filter}
+		options.PageNumber = pageNumber
+
+		l, err := client.Admin.TerraformVersions.List(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var versions []toolVersion
+		for _, v := range l.Items {
+			versions = append(versions, toolVersion{ID: v.ID, Version: v.Version})
+		}
+
+		return versions, l.Pagination, nil
+	})
+}
+
+// fetchSentinelVersionID returns a Sentinel Version ID for the given Sentinel version number
+func fetchSentinelVersionID(version string, client *tfe.Client) (string, error) {
+	return fetchToolVersionID("Sentinel", version, func(filter string, pageNumber int) ([]toolVersion, *tfe.Pagination, error) {
+		options := &tfe.AdminSentinelVersionsListOptions{Filter: filter}
+		options.PageNumber = pageNumber
+
+		l, err := client.Admin.SentinelVersions.List(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var versions []toolVersion
+		for _, v := range l.Items {
+			versions = append(versions, toolVersion{ID: v.ID, Version: v.Version})
+		}
+
+		return versions, l.Pagination, nil
+	})
+}
+
+// fetchOPAVersionID returns an OPA Version ID for the given OPA version number
+func fetchOPAVersionID(version string, client *tfe.Client) (string, error) {
+	return fetchToolVersionID("OPA", version, func(filter string, pageNumber int) ([]toolVersion, *tfe.Pagination, error) {
+		options := &tfe.AdminOPAVersionsListOptions{Filter: filter}
+		options.PageNumber = pageNumber
+
+		l, err := client.Admin.OPAVersions.List(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var versions []toolVersion
+		for _, v := range l.Items {
+			versions = append(versions, toolVersion{ID: v.ID, Version: v.Version})
+		}
+
+		return versions, l.Pagination, nil
 	})
+}
+
+// fetchToolVersionID returns the ID of the given version of a tool, using
+// list to retrieve the versions of the tool.
+func fetchToolVersionID(tool, version string, list toolVersionListFunc) (string, error) {
+	versions, pagination, err := list(version, 0)
 	if err != nil {
-		return "", fmt.Errorf("error reading Terraform versions: %w", err)
+		return "", fmt.Errorf("error reading %s versions: %w", tool, err)
 	}
 
 	// filter[version] returns 1 item or 0, if however
 	// the number of versions returned is greater than 1,
 	// we can assume the API doesn't support the filter[version] query param
 	// and so we'll use a fallback search mechanism
-	switch len(versions.Items) {
+	switch len(versions) {
 	case 0:
-		return "", fmt.Errorf("terraform version not found")
+		return "", fmt.Errorf("%s version not found", tool)
 	case 1:
-		return versions.Items[0].ID, nil
+		return versions[0].ID, nil
 	default:
-		options := &tfe.AdminTerraformVersionsListOptions{}
 		for {
-			for _, v := range versions.Items {
+			for _, v := range versions {
 				if v.Version == version {
 					return v.ID, nil
 				}
 			}
 
-			if versions.CurrentPage >= versions.TotalPages {
+			if pagination == nil || pagination.CurrentPage >= pagination.TotalPages {
 				break
 			}
 
-			options.PageNumber = versions.NextPage
-
-			versions, err = client.Admin.TerraformVersions.List(ctx, options)
+			versions, pagination, err = list("", pagination.NextPage)
 			if err != nil {
-				return "", fmt.Errorf("error reading Terraform Versions: %w", err)
+				return "", fmt.Errorf("error reading %s versions: %w", tool, err)
 			}
 		}
 	}
 
-	return "", fmt.Errorf("terraform version not found")
+	return "", fmt.Errorf("%s version not found", tool)
+}
+
+// listTerraformVersions returns all Terraform versions available on Terraform
+// Cloud/Enterprise. The versions are only offered by the admin API, so
+// errTerraformVersionsUnavailable is returned when the token has no access to
+// it.
+func listTerraformVersions(client *tfe.Client) ([]*terraformToolVersion, error) {
+	var versions []*terraformToolVersion
+
+	options := &tfe.AdminTerraformVersionsListOptions{}
+	for {
+		l, err := client.Admin.TerraformVersions.List(ctx, options)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound || err == tfe.ErrUnauthorized {
+				return nil, errTerraformVersionsUnavailable
+			}
+			return nil, fmt.Errorf("error reading Terraform versions: %w", err)
+		}
+
+		for _, v := range l.Items {
+			versions = append(versions, &terraformToolVersion{
+				ID:         v.ID,
+				Version:    v.Version,
+				Official:   v.Official,
+				Enabled:    v.Enabled,
+				Beta:       v.Beta,
+				Deprecated: v.Deprecated,
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return versions, nil
 }
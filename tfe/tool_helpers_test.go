@@ -0,0 +1,67 @@
+package tfe
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestListTerraformVersions(t *testing.T) {
+	tests := map[string]struct {
+		options testClientOptions
+		want    []string
+		err     error
+	}{
+		"all pages": {
+			options: testClientOptions{
+				terraformVersions: []*tfe.AdminTerraformVersion{
+					{ID: "tool-1", Version: "1.4.0", Enabled: true},
+					{ID: "tool-2", Version: "1.5.0", Enabled: true, Deprecated: true},
+				},
+			},
+			want: []string{"1.4.0", "1.5.0"},
+		},
+		"no admin access": {
+			options: testClientOptions{terraformVersionsError: tfe.ErrResourceNotFound},
+			err:     errTerraformVersionsUnavailable,
+		},
+		"unauthorized": {
+			options: testClientOptions{terraformVersionsError: tfe.ErrUnauthorized},
+			err:     errTerraformVersionsUnavailable,
+		},
+		"server error": {
+			options: testClientOptions{terraformVersionsError: errors.New("internal server error")},
+			err:     errors.New("error reading Terraform versions: internal server error"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := testTfeClient(t, test.options)
+
+			versions, err := listTerraformVersions(client)
+			if test.err != nil {
+				if err == nil || err.Error() != test.err.Error() {
+					t.Fatalf("expected error %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, v := range versions {
+				got = append(got, v.Version)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("expected versions %v, got %v", test.want, got)
+			}
+			if !versions[1].Deprecated {
+				t.Fatal("expected version 1.5.0 to be deprecated")
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
@@ -104,3 +107,148 @@ func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []interfa
 
 	return false, remoteStateConsumerIDs, nil
 }
+
+// assessmentResultSummary represents the summary of a health assessment
+// result. The assessment results API is not yet covered by go-tfe.
+type assessmentResultSummary struct {
+	ID                 string    `jsonapi:"primary,assessment-results"`
+	Drifted            bool      `jsonapi:"attr,drifted"`
+	Succeeded          bool      `jsonapi:"attr,succeeded"`
+	ErrorMsg           string    `jsonapi:"attr,error-msg"`
+	ResourcesDrifted   int       `jsonapi:"attr,resources-drifted"`
+	ResourcesUndrifted int       `jsonapi:"attr,resources-undrifted"`
+	ChecksPassed       int       `jsonapi:"attr,checks-passed"`
+	ChecksFailed       int       `jsonapi:"attr,checks-failed"`
+	ChecksErrored      int       `jsonapi:"attr,checks-errored"`
+	ChecksUnknown      int       `jsonapi:"attr,checks-unknown"`
+	CreatedAt          time.Time `jsonapi:"attr,created-at,iso8601"`
+}
+
+// readWorkspaceCurrentAssessmentResult returns the latest health assessment
+// result of a workspace, or nil if no assessment has run yet.
+func readWorkspaceCurrentAssessmentResult(id string, client *tfe.Client) (*assessmentResultSummary, error) {
+	path := fmt.Sprintf("workspaces/%s/current-assessment-result", url.QueryEscape(id))
+	req, err := client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &assessmentResultSummary{}
+	err = req.Do(ctx, result)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound || err == tfe.ErrUnauthorized || isForbiddenError(err) {
+			// Either no assessment has run yet, this version of Terraform
+			// Enterprise does not support health assessments, or the
+			// organization isn't entitled to them.
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// isForbiddenError reports whether err is the error go-tfe returns for a 403
+// response. Unlike a 401 or 404 it has no sentinel error, so the error is
+// matched on the "forbidden" title of the error payload, or on the response
+// status when the response has no payload.
+func isForbiddenError(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "forbidden")
+}
+
+// readWorkspaceReadme returns the README of a workspace, or an empty string
+// if the workspace has no README or the token isn't allowed to read it.
+func readWorkspaceReadme(id string, client *tfe.Client) (string, error) {
+	r, err := client.Workspaces.Readme(ctx, id)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound || err == tfe.ErrUnauthorized || isForbiddenError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if r == nil {
+		return "", nil
+	}
+
+	readme, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	return string(readme), nil
+}
+
+// readWorkspaceLastAppliedAt returns the time the latest applied run of a
+// workspace was applied, or an empty string if no run was applied yet or the
+// token isn't allowed to list runs.
+func readWorkspaceLastAppliedAt(id string, client *tfe.Client) (string, error) {
+	// Runs are listed from newest to oldest, so the first one is the latest.
+	options := &tfe.RunListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+		Status:      string(tfe.RunApplied),
+	}
+
+	rl, err := client.Runs.List(ctx, id, options)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound || err == tfe.ErrUnauthorized || isForbiddenError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(rl.Items) == 0 || rl.Items[0].StatusTimestamps == nil {
+		return "", nil
+	}
+
+	appliedAt := rl.Items[0].StatusTimestamps.AppliedAt
+	if appliedAt.IsZero() {
+		return "", nil
+	}
+
+	return appliedAt.Format(time.RFC3339), nil
+}
+
+// listWorkspacesBySelector returns all workspaces of an organization that
+// match the given names and tag names. A name of "*" matches every workspace,
+// and when only tag names are given every workspace carrying all of those
+// tags is returned. If a project ID is given, only workspaces belonging to
+// that project are considered.
+func listWorkspacesBySelector(client *tfe.Client, organization string, names, tagNames []string, projectID string) ([]*tfe.Workspace, error) {
+	nameSet := make(map[string]bool, len(names))
+	for _, name := range names {
+		nameSet[name] = true
+	}
+	isWildcard := nameSet["*"]
+
+	options := &tfe.WorkspaceListOptions{
+		ProjectID: projectID,
+	}
+	if len(tagNames) > 0 {
+		options.Tags = strings.Join(tagNames, ",")
+	}
+
+	hasOnlyTags := len(tagNames) > 0 && len(nameSet) == 0
+
+	var workspaces []*tfe.Workspace
+	for {
+		wl, err := client.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving workspaces: %v", err)
+		}
+
+		for _, w := range wl.Items {
+			if hasOnlyTags || isWildcard || nameSet[w.Name] {
+				workspaces = append(workspaces, w)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	return workspaces, nil
+}
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -227,3 +228,93 @@ func TestReadWorkspaceStateConsumers(t *testing.T) {
 		}
 	}
 }
+
+func TestReadWorkspaceReadme(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{
+		defaultWorkspaceID: "ws-123",
+		readmes: map[string]string{
+			"ws-123": "# Payments\n\nOwned by the payments team.\n",
+		},
+		readmeErrors: map[string]error{
+			"ws-unauthorized": tfe.ErrUnauthorized,
+			"ws-forbidden":    errors.New("forbidden"),
+			"ws-unavailable":  errors.New("503 Service Unavailable"),
+		},
+	})
+
+	cases := map[string]struct {
+		id     string
+		readme string
+		err    bool
+	}{
+		"workspace with a README": {
+			id:     "ws-123",
+			readme: "# Payments\n\nOwned by the payments team.\n",
+		},
+		"workspace without a README": {
+			id:     "ws-456",
+			readme: "",
+		},
+		"unauthorized": {
+			id:     "ws-unauthorized",
+			readme: "",
+		},
+		"forbidden": {
+			id:     "ws-forbidden",
+			readme: "",
+		},
+		"server error": {
+			id:  "ws-unavailable",
+			err: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			readme, err := readWorkspaceReadme(tc.id, client)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error is %t, got %v", tc.err, err)
+			}
+
+			if readme != tc.readme {
+				t.Fatalf("expected README %q, got %q", tc.readme, readme)
+			}
+		})
+	}
+}
+
+func TestIsForbiddenError(t *testing.T) {
+	cases := map[string]struct {
+		err       error
+		forbidden bool
+	}{
+		"no error": {
+			err:       nil,
+			forbidden: false,
+		},
+		"error payload": {
+			err:       errors.New("forbidden"),
+			forbidden: true,
+		},
+		"response status": {
+			err:       errors.New("403 Forbidden"),
+			forbidden: true,
+		},
+		"unauthorized": {
+			err:       tfe.ErrUnauthorized,
+			forbidden: false,
+		},
+		"server error": {
+			err:       errors.New("500 Internal Server Error"),
+			forbidden: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if forbidden := isForbiddenError(tc.err); forbidden != tc.forbidden {
+				t.Fatalf("expected forbidden to be %t, got %t", tc.forbidden, forbidden)
+			}
+		})
+	}
+}